@@ -6,6 +6,7 @@ require (
 	github.com/aws/aws-sdk-go v1.44.176
 	github.com/hashicorp/vault/api v1.8.0
 	github.com/turbot/steampipe-plugin-sdk/v5 v5.6.1
+	google.golang.org/protobuf v1.31.0
 )
 
 require (
@@ -135,7 +136,6 @@ require (
 	google.golang.org/genproto/googleapis/api v0.0.0-20230711160842-782d3b101e98 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230711160842-782d3b101e98 // indirect
 	google.golang.org/grpc v1.58.2 // indirect
-	google.golang.org/protobuf v1.31.0 // indirect
 	gopkg.in/natefinch/lumberjack.v2 v2.0.0 // indirect
 	gopkg.in/square/go-jose.v2 v2.6.0 // indirect
 	gopkg.in/tomb.v2 v2.0.0-20161208151619-d5d1b5820637 // indirect
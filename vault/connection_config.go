@@ -11,6 +11,13 @@ type vaultConfig struct {
 	AuthType    *string `cty:"auth_type"`
 	AwsProvider *string `cty:"aws_provider"`
 	AwsRole     *string `cty:"aws_role"`
+
+	TokenLookupConcurrency *int    `cty:"token_lookup_concurrency"`
+	AuditLogPath           *string `cty:"audit_log_path"`
+	RaftSnapshotPath       *string `cty:"raft_snapshot_path"`
+	MountCacheTtl          *int    `cty:"mount_cache_ttl"`
+
+	NodeAddresses []string `cty:"node_addresses"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -29,6 +36,22 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"aws_role": {
 		Type: schema.TypeString,
 	},
+	"token_lookup_concurrency": {
+		Type: schema.TypeInt,
+	},
+	"audit_log_path": {
+		Type: schema.TypeString,
+	},
+	"raft_snapshot_path": {
+		Type: schema.TypeString,
+	},
+	"mount_cache_ttl": {
+		Type: schema.TypeInt,
+	},
+	"node_addresses": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
 }
 
 func ConfigInstance() interface{} {
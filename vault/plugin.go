@@ -16,15 +16,45 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		},
 		DefaultTransform: transform.FromGo().NullIfZero(),
 		TableMap: map[string]*plugin.Table{
-			"vault_engine":       tableEngine(),
-			"vault_kv_secret":    tableKvSecret(),
-			"vault_sys_health":   tableSysHealth(),
-			"vault_aws_role":     tableAwsRole(),
-			"vault_pki_cert":     tablePkiCert(),
-			"vault_pki_role":     tablePkiRole(),
-			"vault_auth":         tableAuth(),
-			"vault_azure_config": tableAzureConfig(),
-			"vault_azure_role":   tableAzureRole(),
+			"vault_engine":                    tableEngine(),
+			"vault_kv_secret":                 tableKvSecret(),
+			"vault_sys_health":                tableSysHealth(),
+			"vault_sys_seal_status":           tableSysSealStatus(),
+			"vault_sys_leader":                tableSysLeader(),
+			"vault_raft_peer":                 tableRaftPeer(),
+			"vault_raft_autopilot":            tableRaftAutopilot(),
+			"vault_raft_snapshot":             tableRaftSnapshot(),
+			"vault_replication_status":        tableReplicationStatus(),
+			"vault_plugin_catalog":            tablePluginCatalog(),
+			"vault_version_history":           tableVersionHistory(),
+			"vault_license":                   tableLicense(),
+			"vault_quota_rate_limit":          tableQuotaRateLimit(),
+			"vault_quota_lease_count":         tableQuotaLeaseCount(),
+			"vault_quota_config":              tableQuotaConfig(),
+			"vault_client_count":              tableClientCount(),
+			"vault_metric":                    tableMetric(),
+			"vault_host_info":                 tableHostInfo(),
+			"vault_in_flight_request":         tableInFlightRequest(),
+			"vault_lease":                     tableLease(),
+			"vault_lease_count":               tableLeaseCount(),
+			"vault_aws_role":                  tableAwsRole(),
+			"vault_pki_cert":                  tablePkiCert(),
+			"vault_pki_role":                  tablePkiRole(),
+			"vault_auth":                      tableAuth(),
+			"vault_mount_tune":                tableMountTune(),
+			"vault_azure_config":              tableAzureConfig(),
+			"vault_azure_role":                tableAzureRole(),
+			"vault_token_self":                tableTokenSelf(),
+			"vault_token":                     tableToken(),
+			"vault_token_role":                tableTokenRole(),
+			"vault_identity_entity":           tableIdentityEntity(),
+			"vault_identity_entity_alias":     tableIdentityEntityAlias(),
+			"vault_identity_group":            tableIdentityGroup(),
+			"vault_identity_group_alias":      tableIdentityGroupAlias(),
+			"vault_identity_effective_policy": tableIdentityEffectivePolicy(),
+			"vault_audit_device":              tableAuditDevice(),
+			"vault_audit_log":                 tableAuditLog(),
+			"vault_audit_hash":                tableAuditHash(),
 		},
 	}
 
@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// AuditDevice The structure of an audit device.
+// Well known options are flattened into their own fields, all options remain available through Options.
+type AuditDevice struct {
+	Path               string
+	Type               string
+	Description        string
+	Local              bool
+	FilePath           string
+	Format             string
+	Prefix             string
+	LogRaw             bool
+	HmacAccessor       bool
+	ElideListResponses bool
+	Mode               string
+	Address            string
+	SocketType         string
+	Facility           string
+	Tag                string
+	Options            map[string]string
+}
+
+func tableAuditDevice() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_audit_device",
+		Description: "Vault Audit Devices",
+		List: &plugin.ListConfig{
+			Hydrate: listAuditDevices,
+		},
+		Columns: []*plugin.Column{
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the audit device"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of audit device, file, syslog or socket"},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "Description associated to the audit device"},
+			{Name: "local", Type: proto.ColumnType_BOOL, Description: "Local audit devices are not replicated across clusters", Transform: transform.FromGo()},
+			{Name: "file_path", Type: proto.ColumnType_STRING, Description: "The path of the file audit logs are written to (file devices only)"},
+			{Name: "format", Type: proto.ColumnType_STRING, Description: "The format of the audit log, json or jsonx"},
+			{Name: "prefix", Type: proto.ColumnType_STRING, Description: "Prefix written in front of each audit log entry"},
+			{Name: "log_raw", Type: proto.ColumnType_BOOL, Description: "Indication if sensitive values are logged without being hashed", Transform: transform.FromGo()},
+			{Name: "hmac_accessor", Type: proto.ColumnType_BOOL, Description: "Indication if token accessors are hashed", Transform: transform.FromGo()},
+			{Name: "elide_list_responses", Type: proto.ColumnType_BOOL, Description: "Indication if the keys of list responses are left out", Transform: transform.FromGo()},
+			{Name: "mode", Type: proto.ColumnType_STRING, Description: "The file mode of the audit log (file devices only)"},
+			{Name: "address", Type: proto.ColumnType_STRING, Description: "The address audit logs are sent to (socket devices only)"},
+			{Name: "socket_type", Type: proto.ColumnType_STRING, Description: "The type of socket, example 'tcp' (socket devices only)"},
+			{Name: "facility", Type: proto.ColumnType_STRING, Description: "The syslog facility (syslog devices only)"},
+			{Name: "tag", Type: proto.ColumnType_STRING, Description: "The syslog tag (syslog devices only)"},
+			{Name: "options", Type: proto.ColumnType_JSON, Description: "All options configured for the audit device"},
+		},
+	}
+}
+
+func listAuditDevices(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := conn.Sys().ListAudit()
+	if err != nil {
+		return nil, err
+	}
+
+	for path, device := range devices {
+		d.StreamListItem(ctx, &AuditDevice{
+			Path:               path,
+			Type:               device.Type,
+			Description:        device.Description,
+			Local:              device.Local,
+			FilePath:           device.Options["file_path"],
+			Format:             device.Options["format"],
+			Prefix:             device.Options["prefix"],
+			LogRaw:             getOptionBool(device.Options, "log_raw", false),
+			HmacAccessor:       getOptionBool(device.Options, "hmac_accessor", true),
+			ElideListResponses: getOptionBool(device.Options, "elide_list_responses", false),
+			Mode:               device.Options["mode"],
+			Address:            device.Options["address"],
+			SocketType:         device.Options["socket_type"],
+			Facility:           device.Options["facility"],
+			Tag:                device.Options["tag"],
+			Options:            device.Options,
+		})
+	}
+
+	return nil, nil
+}
+
+// Obtains a boolean option, Vault stores these as strings. Returns the Vault default if not set
+func getOptionBool(options map[string]string, key string, defaultValue bool) bool {
+	b, err := strconv.ParseBool(options[key])
+	if err != nil {
+		return defaultValue
+	}
+
+	return b
+}
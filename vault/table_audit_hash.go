@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+type AuditHash struct {
+	AuditPath string
+	Input     string
+	Hash      string
+}
+
+func tableAuditHash() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_audit_hash",
+		Description: "Hash of a value as it would appear in the log of a Vault Audit Device",
+		List: &plugin.ListConfig{
+			Hydrate:    getAuditHash,
+			KeyColumns: plugin.AllColumns([]string{"audit_path", "input"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "audit_path", Type: proto.ColumnType_STRING, Description: "The path of the audit device whose HMAC key is used"},
+			{Name: "input", Type: proto.ColumnType_STRING, Description: "The value to hash"},
+			{Name: "hash", Type: proto.ColumnType_STRING, Description: "The hash of the value, example 'hmac-sha256:...'"},
+		},
+	}
+}
+
+func getAuditHash(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	path := quals["audit_path"].GetStringValue()
+	input := quals["input"].GetStringValue()
+
+	hash, err := conn.Sys().AuditHash(path, input)
+	if err != nil {
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, &AuditHash{
+		AuditPath: path,
+		Input:     input,
+		Hash:      hash,
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,273 @@
+package vault
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// AuditLogEntry A single line of a Vault file audit device log.
+// Auth, Request and Response hold the complete (HMAC'd) objects as logged by Vault.
+type AuditLogEntry struct {
+	File          string
+	Time          time.Time
+	Type          string
+	RequestID     string
+	AuthAccessor  string
+	DisplayName   string
+	Policies      []string
+	EntityID      string
+	Operation     string
+	Path          string
+	MountType     string
+	RemoteAddress string
+	Error         string
+	Auth          map[string]interface{}
+	Request       map[string]interface{}
+	Response      map[string]interface{}
+}
+
+// The parts of an audit log line we need to fill the columns, everything else is kept in the raw maps
+type auditLogLine struct {
+	Time  time.Time `json:"time"`
+	Type  string    `json:"type"`
+	Error string    `json:"error"`
+	Auth  struct {
+		Accessor    string   `json:"accessor"`
+		DisplayName string   `json:"display_name"`
+		Policies    []string `json:"policies"`
+		EntityID    string   `json:"entity_id"`
+	} `json:"auth"`
+	Request struct {
+		ID            string `json:"id"`
+		Operation     string `json:"operation"`
+		Path          string `json:"path"`
+		MountType     string `json:"mount_type"`
+		RemoteAddress string `json:"remote_address"`
+	} `json:"request"`
+	Raw struct {
+		Auth     map[string]interface{} `json:"auth"`
+		Request  map[string]interface{} `json:"request"`
+		Response map[string]interface{} `json:"response"`
+	} `json:"-"`
+}
+
+func tableAuditLog() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_audit_log",
+		Description: "Entries of local Vault audit log files",
+		List: &plugin.ListConfig{
+			Hydrate: listAuditLog,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "time", Require: plugin.Optional, Operators: []string{">", ">=", "=", "<", "<="}},
+				{Name: "path", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "file", Type: proto.ColumnType_STRING, Description: "The audit log file the entry was read from"},
+			{Name: "time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time of the entry"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of entry, request or response"},
+			{Name: "request_id", Type: proto.ColumnType_STRING, Description: "The identifier of the request, shared by the request and response entries", Transform: transform.FromField("RequestID")},
+			{Name: "auth_accessor", Type: proto.ColumnType_STRING, Description: "The (HMAC'd) accessor of the token used for the request"},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the token used for the request"},
+			{Name: "policies", Type: proto.ColumnType_JSON, Description: "Array of policies of the token used for the request"},
+			{Name: "entity_id", Type: proto.ColumnType_STRING, Description: "The identifier of the identity entity the token belongs to", Transform: transform.FromField("EntityID")},
+			{Name: "operation", Type: proto.ColumnType_STRING, Description: "The operation of the request, example 'read' or 'update'"},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path of the request"},
+			{Name: "mount_type", Type: proto.ColumnType_STRING, Description: "The type of the mount the request was handled by"},
+			{Name: "remote_address", Type: proto.ColumnType_STRING, Description: "The address of the client making the request"},
+			{Name: "error", Type: proto.ColumnType_STRING, Description: "The error returned, if any"},
+			{Name: "auth", Type: proto.ColumnType_JSON, Description: "The auth object of the entry, sensitive values are HMAC'd"},
+			{Name: "request", Type: proto.ColumnType_JSON, Description: "The request object of the entry, sensitive values are HMAC'd"},
+			{Name: "response", Type: proto.ColumnType_JSON, Description: "The response object of the entry, sensitive values are HMAC'd"},
+		},
+	}
+}
+
+// The function called by steampipe to populate the table. Reads all files matching the configured audit_log_path
+func listAuditLog(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	config := GetConfig(d.Connection)
+	if config.AuditLogPath == nil || *config.AuditLogPath == "" {
+		return nil, errors.New("audit_log_path must be set in the connection configuration file to query vault_audit_log")
+	}
+
+	files, err := filepath.Glob(*config.AuditLogPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("audit_log_path %s does not match any files", *config.AuditLogPath)
+	}
+
+	path := d.EqualsQuals["path"].GetStringValue()
+	var timeQuals []*quals.Qual
+	if d.Quals["time"] != nil {
+		timeQuals = d.Quals["time"].Quals
+	}
+
+	for _, file := range files {
+		err := readAuditLogFile(ctx, d, file, path, timeQuals)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// Streams the entries of a single (optionally gzipped) audit log file.
+// Lines not matching the path or time quals are skipped before being fully parsed
+func readAuditLogFile(ctx context.Context, d *plugin.QueryData, file string, path string, timeQuals []*quals.Qual) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(file, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			if entry := parseAuditLogLine(line, path, timeQuals); entry != nil {
+				entry.File = file
+				d.StreamListItem(ctx, entry)
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Stop reading when the query is cancelled or the limit has been reached
+		if d.RowsRemaining(ctx) == 0 {
+			return nil
+		}
+	}
+}
+
+// Parses an audit log line, returns nil if the line could not be parsed or does not match the quals
+func parseAuditLogLine(line []byte, path string, timeQuals []*quals.Qual) *AuditLogEntry {
+	if path != "" && !bytes.Contains(line, []byte(path)) {
+		return nil
+	}
+
+	if len(timeQuals) > 0 {
+		t, ok := getAuditLogLineTime(line)
+		if ok && !matchesTimeQuals(t, timeQuals) {
+			return nil
+		}
+	}
+
+	var l auditLogLine
+	if err := json.Unmarshal(line, &l); err != nil {
+		return nil
+	}
+	if err := json.Unmarshal(line, &l.Raw); err != nil {
+		return nil
+	}
+
+	if path != "" && l.Request.Path != path {
+		return nil
+	}
+	if !matchesTimeQuals(l.Time, timeQuals) {
+		return nil
+	}
+
+	return &AuditLogEntry{
+		Time:          l.Time,
+		Type:          l.Type,
+		RequestID:     l.Request.ID,
+		AuthAccessor:  l.Auth.Accessor,
+		DisplayName:   l.Auth.DisplayName,
+		Policies:      l.Auth.Policies,
+		EntityID:      l.Auth.EntityID,
+		Operation:     l.Request.Operation,
+		Path:          l.Request.Path,
+		MountType:     l.Request.MountType,
+		RemoteAddress: l.Request.RemoteAddress,
+		Error:         l.Error,
+		Auth:          l.Raw.Auth,
+		Request:       l.Raw.Request,
+		Response:      l.Raw.Response,
+	}
+}
+
+// Obtains the time of an audit log line without parsing the entire line
+func getAuditLogLineTime(line []byte) (time.Time, bool) {
+	marker := []byte(`"time":"`)
+	start := bytes.Index(line, marker)
+	if start < 0 {
+		return time.Time{}, false
+	}
+	start += len(marker)
+
+	end := bytes.IndexByte(line[start:], '"')
+	if end < 0 {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, string(line[start:start+end]))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// Checks whether a time satisfies all time quals
+func matchesTimeQuals(t time.Time, timeQuals []*quals.Qual) bool {
+	for _, q := range timeQuals {
+		value := q.Value.GetTimestampValue().AsTime()
+		switch q.Operator {
+		case ">":
+			if !t.After(value) {
+				return false
+			}
+		case ">=":
+			if t.Before(value) {
+				return false
+			}
+		case "=":
+			if !t.Equal(value) {
+				return false
+			}
+		case "<":
+			if !t.Before(value) {
+				return false
+			}
+		case "<=":
+			if t.After(value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -12,6 +12,7 @@ type AuthMethod struct {
 	Type                  string
 	Description           string
 	Accessor              string
+	UUID                  string
 	Local                 bool
 	SealWrap              bool
 	ExternalEntropyAccess bool
@@ -19,8 +20,11 @@ type AuthMethod struct {
 	MaxTtl                int
 	RequestHeaders        []string
 	PluginVersion         string
+	RunningPluginVersion  string
+	RunningSha256         string
 	DeprecationStatus     string
 	Options               map[string]string
+	PluginCatalogKey      string
 }
 
 func tableAuth() *plugin.Table {
@@ -31,7 +35,7 @@ func tableAuth() *plugin.Table {
 			Hydrate: listAuth,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("path"),
+			KeyColumns: plugin.AnyColumn([]string{"path", "accessor"}),
 			Hydrate:    getAuth,
 		},
 		Columns: authColumns(),
@@ -44,7 +48,7 @@ func listAuth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 		return nil, err
 	}
 
-	auths, err := conn.Sys().ListAuth()
+	auths, err := getAuthMounts(ctx, d, conn)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +59,7 @@ func listAuth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 			Type:                  auth.Type,
 			Description:           auth.Description,
 			Accessor:              auth.Accessor,
+			UUID:                  auth.UUID,
 			Local:                 auth.Local,
 			SealWrap:              auth.SealWrap,
 			ExternalEntropyAccess: auth.ExternalEntropyAccess,
@@ -62,8 +67,11 @@ func listAuth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (
 			MaxTtl:                auth.Config.MaxLeaseTTL,
 			RequestHeaders:        auth.Config.PassthroughRequestHeaders,
 			PluginVersion:         auth.PluginVersion,
+			RunningPluginVersion:  auth.RunningVersion,
+			RunningSha256:         auth.RunningSha256,
 			DeprecationStatus:     auth.DeprecationStatus,
 			Options:               auth.Options,
+			PluginCatalogKey:      getMountPluginCatalogKey("auth", auth),
 		})
 	}
 
@@ -76,13 +84,21 @@ func getAuth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (i
 		return nil, err
 	}
 
-	auths, err := conn.Sys().ListAuth()
+	auths, err := getAuthMounts(ctx, d, conn)
 	if err != nil {
 		return nil, err
 	}
 
 	q := d.EqualsQuals
-	path := q["path"].GetStringValue()
+	path := findMountPath(auths, q["path"].GetStringValue(), q["accessor"].GetStringValue())
+	if path == "" && invalidateMountCache(ctx, d, authMountCacheKey) {
+		// The authentication method may have been enabled after the mount inventory was cached
+		auths, err = getAuthMounts(ctx, d, conn)
+		if err != nil {
+			return nil, err
+		}
+		path = findMountPath(auths, q["path"].GetStringValue(), q["accessor"].GetStringValue())
+	}
 
 	auth := auths[path]
 	if auth == nil {
@@ -94,6 +110,7 @@ func getAuth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (i
 		Type:                  auth.Type,
 		Description:           auth.Description,
 		Accessor:              auth.Accessor,
+		UUID:                  auth.UUID,
 		Local:                 auth.Local,
 		SealWrap:              auth.SealWrap,
 		ExternalEntropyAccess: auth.ExternalEntropyAccess,
@@ -101,8 +118,11 @@ func getAuth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (i
 		MaxTtl:                auth.Config.MaxLeaseTTL,
 		RequestHeaders:        auth.Config.PassthroughRequestHeaders,
 		PluginVersion:         auth.PluginVersion,
+		RunningPluginVersion:  auth.RunningVersion,
+		RunningSha256:         auth.RunningSha256,
 		DeprecationStatus:     auth.DeprecationStatus,
 		Options:               auth.Options,
+		PluginCatalogKey:      getMountPluginCatalogKey("auth", auth),
 	}, nil
 }
 
@@ -128,6 +148,12 @@ func authColumns() []*plugin.Column {
 			Type:        proto.ColumnType_STRING,
 			Description: "The accessor used by authentication method",
 		},
+		{
+			Name:        "uuid",
+			Type:        proto.ColumnType_STRING,
+			Description: "The unique identifier of the authentication method",
+			Transform:   transform.FromField("UUID"),
+		},
 		{
 			Name:        "local",
 			Type:        proto.ColumnType_BOOL,
@@ -164,6 +190,17 @@ func authColumns() []*plugin.Column {
 			Type:        proto.ColumnType_STRING,
 			Description: "Information about the plugin used for the authentication method",
 		},
+		{
+			Name:        "running_plugin_version",
+			Type:        proto.ColumnType_STRING,
+			Description: "The version of the plugin the authentication method is currently running",
+		},
+		{
+			Name:        "running_sha256",
+			Type:        proto.ColumnType_STRING,
+			Description: "The SHA256 sum of the plugin binary the authentication method is currently running (external plugins only)",
+			Transform:   transform.FromField("RunningSha256"),
+		},
 		{
 			Name:        "deprecation_status",
 			Type:        proto.ColumnType_STRING,
@@ -174,5 +211,10 @@ func authColumns() []*plugin.Column {
 			Type:        proto.ColumnType_JSON,
 			Description: "The option configuration associated with the authentication method",
 		},
+		{
+			Name:        "plugin_catalog_key",
+			Type:        proto.ColumnType_STRING,
+			Description: "Key of the plugin catalog entry the authentication method is running, join on vault_plugin_catalog.catalog_key",
+		},
 	}
 }
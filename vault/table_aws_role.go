@@ -58,7 +58,7 @@ func listRoles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return nil, err
 	}
 
-	allMounts, err := conn.Sys().ListMounts()
+	allMounts, err := getMounts(ctx, d, conn)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +38,7 @@ func listAzureConfigs(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrat
 		return nil, err
 	}
 
-	allMounts, err := conn.Sys().ListMounts()
+	allMounts, err := getMounts(ctx, d, conn)
 	if err != nil {
 		return nil, err
 	}
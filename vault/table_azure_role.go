@@ -32,7 +32,7 @@ func listAzureRoles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 		return nil, err
 	}
 
-	allMounts, err := conn.Sys().ListMounts()
+	allMounts, err := getMounts(ctx, d, conn)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// ClientCount The number of clients for the billing period, a namespace, a mount or a month.
+// Type identifies the breakdown: total, namespace, mount or month.
+type ClientCount struct {
+	Type             string
+	StartTime        *time.Time
+	EndTime          *time.Time
+	Month            *time.Time
+	NamespaceID      string
+	NamespacePath    string
+	MountPath        string
+	Clients          int64
+	EntityClients    int64
+	NonEntityClients int64
+	SecretSyncs      int64
+}
+
+func tableClientCount() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_client_count",
+		Description: "Vault Client counts from the activity log",
+		List: &plugin.ListConfig{
+			Hydrate:    listClientCounts,
+			KeyColumns: plugin.OptionalColumns([]string{"requested_start_time", "requested_end_time"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of count, total, namespace, mount or month"},
+			{Name: "start_time", Type: proto.ColumnType_TIMESTAMP, Description: "The start of the period the clients were counted for, Vault rounds this to the start of the month"},
+			{Name: "end_time", Type: proto.ColumnType_TIMESTAMP, Description: "The end of the period the clients were counted for, Vault rounds this to the end of the month"},
+			{Name: "requested_start_time", Type: proto.ColumnType_TIMESTAMP, Description: "The start of the period to count the clients for, defaults to the start of the billing period", Transform: transform.FromQual("requested_start_time")},
+			{Name: "requested_end_time", Type: proto.ColumnType_TIMESTAMP, Description: "The end of the period to count the clients for, defaults to the end of the last month", Transform: transform.FromQual("requested_end_time")},
+			{Name: "month", Type: proto.ColumnType_TIMESTAMP, Description: "The month the clients were counted for (month rows only)"},
+			{Name: "namespace_id", Type: proto.ColumnType_STRING, Description: "The identifier of the namespace (namespace and mount rows only)", Transform: transform.FromField("NamespaceID")},
+			{Name: "namespace_path", Type: proto.ColumnType_STRING, Description: "The path of the namespace (namespace and mount rows only)"},
+			{Name: "mount_path", Type: proto.ColumnType_STRING, Description: "The path of the mount (mount rows only)"},
+			{Name: "clients", Type: proto.ColumnType_INT, Description: "The total number of clients", Transform: transform.FromGo()},
+			{Name: "entity_clients", Type: proto.ColumnType_INT, Description: "The number of clients with an identity entity", Transform: transform.FromGo()},
+			{Name: "non_entity_clients", Type: proto.ColumnType_INT, Description: "The number of clients without an identity entity", Transform: transform.FromGo()},
+			{Name: "secret_syncs", Type: proto.ColumnType_INT, Description: "The number of secret sync destinations", Transform: transform.FromGo()},
+		},
+	}
+}
+
+// The function called by steampipe to populate the table. Returns the totals followed by the
+// per namespace, per mount and per month breakdowns
+func listClientCounts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string][]string{}
+	quals := d.EqualsQuals
+	if quals["requested_start_time"] != nil {
+		params["start_time"] = []string{quals["requested_start_time"].GetTimestampValue().AsTime().Format(time.RFC3339)}
+	}
+	if quals["requested_end_time"] != nil {
+		params["end_time"] = []string{quals["requested_end_time"].GetTimestampValue().AsTime().Format(time.RFC3339)}
+	}
+
+	data, err := conn.Logical().ReadWithData("sys/internal/counters/activity", params)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil || data.Data == nil {
+		return nil, nil
+	}
+
+	startTime := getTime(data.Data, "start_time")
+	endTime := getTime(data.Data, "end_time")
+
+	var counts []*ClientCount
+
+	total := newClientCount("total", getMap(data.Data, "total"))
+	counts = append(counts, total)
+
+	for _, ns := range getMaps(data.Data, "by_namespace") {
+		nsCount := newClientCount("namespace", getMap(ns, "counts"))
+		nsCount.NamespaceID = getString(ns, "namespace_id")
+		nsCount.NamespacePath = getString(ns, "namespace_path")
+		counts = append(counts, nsCount)
+
+		for _, m := range getMaps(ns, "mounts") {
+			mountCount := newClientCount("mount", getMap(m, "counts"))
+			mountCount.NamespaceID = nsCount.NamespaceID
+			mountCount.NamespacePath = nsCount.NamespacePath
+			mountCount.MountPath = getString(m, "mount_path")
+			counts = append(counts, mountCount)
+		}
+	}
+
+	for _, m := range getMaps(data.Data, "months") {
+		monthCount := newClientCount("month", getMap(m, "counts"))
+		monthCount.Month = getTime(m, "timestamp")
+		counts = append(counts, monthCount)
+	}
+
+	for _, c := range counts {
+		c.StartTime = startTime
+		c.EndTime = endTime
+		d.StreamListItem(ctx, c)
+	}
+
+	return nil, nil
+}
+
+// Creates a ClientCount from a counts object. Older versions of Vault report distinct_entities and non_entity_tokens instead
+func newClientCount(countType string, counts map[string]interface{}) *ClientCount {
+	c := &ClientCount{
+		Type:             countType,
+		Clients:          getInt64(counts, "clients"),
+		EntityClients:    getInt64(counts, "entity_clients"),
+		NonEntityClients: getInt64(counts, "non_entity_clients"),
+		SecretSyncs:      getInt64(counts, "secret_syncs"),
+	}
+
+	if c.EntityClients == 0 {
+		c.EntityClients = getInt64(counts, "distinct_entities")
+	}
+	if c.NonEntityClients == 0 {
+		c.NonEntityClients = getInt64(counts, "non_entity_tokens")
+	}
+
+	return c
+}
@@ -6,21 +6,27 @@ import (
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 type Engine struct {
-	Path              string
-	Type              string
-	Description       string
-	Accessor          string
-	Version           int64
-	Local             bool
-	SealWrap          bool
-	DefaultTtl        int
-	MaxTtl            int
-	PluginVersion     string
-	DeprecationStatus string
-	Options           map[string]string
+	Path                  string
+	Type                  string
+	Description           string
+	Accessor              string
+	UUID                  string
+	Version               int64
+	Local                 bool
+	SealWrap              bool
+	ExternalEntropyAccess bool
+	DefaultTtl            int
+	MaxTtl                int
+	PluginVersion         string
+	RunningPluginVersion  string
+	RunningSha256         string
+	DeprecationStatus     string
+	Options               map[string]string
+	PluginCatalogKey      string
 }
 
 func tableEngine() *plugin.Table {
@@ -31,7 +37,7 @@ func tableEngine() *plugin.Table {
 			Hydrate: listEngines,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("path"),
+			KeyColumns: plugin.AnyColumn([]string{"path", "accessor"}),
 			Hydrate:    getEngine,
 		},
 		Columns: engineColumns(),
@@ -45,7 +51,11 @@ func listEngines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 		return nil, err
 	}
 
-	data, err := conn.Sys().ListMounts()
+	data, err := getMounts(ctx, d, conn)
+	if err != nil {
+		return nil, err
+	}
+
 	for path := range data {
 		ver, err := strconv.ParseInt(data[path].Options["version"], 0, 32)
 		if err != nil {
@@ -53,18 +63,23 @@ func listEngines(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 		}
 
 		d.StreamListItem(ctx, &Engine{
-			Type:              data[path].Type,
-			Path:              path,
-			Description:       data[path].Description,
-			Accessor:          data[path].Accessor,
-			Version:           ver,
-			Local:             data[path].Local,
-			SealWrap:          data[path].SealWrap,
-			DefaultTtl:        data[path].Config.DefaultLeaseTTL,
-			MaxTtl:            data[path].Config.MaxLeaseTTL,
-			PluginVersion:     data[path].PluginVersion,
-			DeprecationStatus: data[path].DeprecationStatus,
-			Options:           data[path].Options,
+			Type:                  data[path].Type,
+			Path:                  path,
+			Description:           data[path].Description,
+			Accessor:              data[path].Accessor,
+			UUID:                  data[path].UUID,
+			Version:               ver,
+			Local:                 data[path].Local,
+			SealWrap:              data[path].SealWrap,
+			ExternalEntropyAccess: data[path].ExternalEntropyAccess,
+			DefaultTtl:            data[path].Config.DefaultLeaseTTL,
+			MaxTtl:                data[path].Config.MaxLeaseTTL,
+			PluginVersion:         data[path].PluginVersion,
+			RunningPluginVersion:  data[path].RunningVersion,
+			RunningSha256:         data[path].RunningSha256,
+			DeprecationStatus:     data[path].DeprecationStatus,
+			Options:               data[path].Options,
+			PluginCatalogKey:      getMountPluginCatalogKey("secret", data[path]),
 		})
 	}
 
@@ -78,14 +93,22 @@ func getEngine(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 		return nil, err
 	}
 
-	data, err := conn.Sys().ListMounts()
+	data, err := getMounts(ctx, d, conn)
 
 	if err != nil {
 		return nil, err
 	}
 
 	quals := d.EqualsQuals
-	path := quals["path"].GetStringValue()
+	path := findMountPath(data, quals["path"].GetStringValue(), quals["accessor"].GetStringValue())
+	if path == "" && invalidateMountCache(ctx, d, mountCacheKey) {
+		// The engine may have been mounted after the mount inventory was cached
+		data, err = getMounts(ctx, d, conn)
+		if err != nil {
+			return nil, err
+		}
+		path = findMountPath(data, quals["path"].GetStringValue(), quals["accessor"].GetStringValue())
+	}
 
 	result := data[path]
 	if result == nil {
@@ -98,18 +121,23 @@ func getEngine(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	}
 
 	return &Engine{
-		Type:              data[path].Type,
-		Path:              path,
-		Description:       data[path].Description,
-		Accessor:          data[path].Accessor,
-		Version:           ver,
-		Local:             data[path].Local,
-		SealWrap:          data[path].SealWrap,
-		DefaultTtl:        data[path].Config.DefaultLeaseTTL,
-		MaxTtl:            data[path].Config.MaxLeaseTTL,
-		PluginVersion:     data[path].PluginVersion,
-		DeprecationStatus: data[path].DeprecationStatus,
-		Options:           data[path].Options,
+		Type:                  data[path].Type,
+		Path:                  path,
+		Description:           data[path].Description,
+		Accessor:              data[path].Accessor,
+		UUID:                  data[path].UUID,
+		Version:               ver,
+		Local:                 data[path].Local,
+		SealWrap:              data[path].SealWrap,
+		ExternalEntropyAccess: data[path].ExternalEntropyAccess,
+		DefaultTtl:            data[path].Config.DefaultLeaseTTL,
+		MaxTtl:                data[path].Config.MaxLeaseTTL,
+		PluginVersion:         data[path].PluginVersion,
+		RunningPluginVersion:  data[path].RunningVersion,
+		RunningSha256:         data[path].RunningSha256,
+		DeprecationStatus:     data[path].DeprecationStatus,
+		Options:               data[path].Options,
+		PluginCatalogKey:      getMountPluginCatalogKey("secret", data[path]),
 	}, nil
 }
 
@@ -135,6 +163,12 @@ func engineColumns() []*plugin.Column {
 			Type:        proto.ColumnType_STRING,
 			Description: "The accessor used by the secrets engine",
 		},
+		{
+			Name:        "uuid",
+			Type:        proto.ColumnType_STRING,
+			Description: "The unique identifier of the secrets engine",
+			Transform:   transform.FromField("UUID"),
+		},
 		{
 			Name:        "version",
 			Type:        proto.ColumnType_INT,
@@ -150,6 +184,11 @@ func engineColumns() []*plugin.Column {
 			Type:        proto.ColumnType_BOOL,
 			Description: "Is the secrets engine running seal wrap (https://www.vaultproject.io/docs/enterprise/sealwrap)",
 		},
+		{
+			Name:        "external_entropy_access",
+			Type:        proto.ColumnType_BOOL,
+			Description: "Does the secrets engine have access to Vaults external entropy source",
+		},
 		{
 			Name:        "default_ttl",
 			Type:        proto.ColumnType_INT,
@@ -165,6 +204,17 @@ func engineColumns() []*plugin.Column {
 			Type:        proto.ColumnType_STRING,
 			Description: "Information about the plugin used for the authentication method",
 		},
+		{
+			Name:        "running_plugin_version",
+			Type:        proto.ColumnType_STRING,
+			Description: "The version of the plugin the secrets engine is currently running",
+		},
+		{
+			Name:        "running_sha256",
+			Type:        proto.ColumnType_STRING,
+			Description: "The SHA256 sum of the plugin binary the secrets engine is currently running (external plugins only)",
+			Transform:   transform.FromField("RunningSha256"),
+		},
 		{
 			Name:        "deprecation_status",
 			Type:        proto.ColumnType_STRING,
@@ -175,5 +225,10 @@ func engineColumns() []*plugin.Column {
 			Type:        proto.ColumnType_JSON,
 			Description: "The option configuration associated with the authentication method",
 		},
+		{
+			Name:        "plugin_catalog_key",
+			Type:        proto.ColumnType_STRING,
+			Description: "Key of the plugin catalog entry the secrets engine is running, join on vault_plugin_catalog.catalog_key",
+		},
 	}
 }
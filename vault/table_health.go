@@ -3,12 +3,16 @@ package vault
 import (
 	"context"
 
+	"github.com/hashicorp/vault/api"
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
 )
 
 type SysHealth struct {
+	NodeAddress                string
+	StatusCode                 int
+	Error                      string
 	Initialized                bool
 	Sealed                     bool
 	Standby                    bool
@@ -40,13 +44,49 @@ func getSysHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 		return nil, err
 	}
 
-	data, err := conn.Sys().Health()
+	// Without node_addresses only the node behind the configured address is queried, failing the query when it is unreachable
+	nodes := GetConfig(d.Connection).NodeAddresses
+	if len(nodes) == 0 {
+		data, err := conn.Sys().Health()
+		if err != nil {
+			return nil, err
+		}
 
+		d.StreamListItem(ctx, newSysHealth(conn.Address(), data))
+		return nil, nil
+	}
+
+	// With aws auth connect() returns a client shared between queries, use a copy to point at the individual nodes
+	nodeConn, err := conn.Clone()
 	if err != nil {
 		return nil, err
 	}
 
-	d.StreamListItem(ctx, &SysHealth{
+	for _, node := range nodes {
+		if err := nodeConn.SetAddress(node); err != nil {
+			return nil, err
+		}
+
+		// Unreachable nodes are reported as a row so the health of the remaining nodes can still be seen
+		data, err := nodeConn.Sys().Health()
+		if err != nil {
+			d.StreamListItem(ctx, &SysHealth{
+				NodeAddress: node,
+				Error:       err.Error(),
+			})
+			continue
+		}
+
+		d.StreamListItem(ctx, newSysHealth(node, data))
+	}
+
+	return nil, nil
+}
+
+func newSysHealth(node string, data *api.HealthResponse) *SysHealth {
+	return &SysHealth{
+		NodeAddress:                node,
+		StatusCode:                 getHealthStatusCode(data),
 		Initialized:                data.Initialized,
 		Sealed:                     data.Sealed,
 		Standby:                    data.Standby,
@@ -58,13 +98,45 @@ func getSysHealth(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDat
 		ClusterName:                data.ClusterName,
 		ClusterID:                  data.ClusterID,
 		LastWal:                    data.LastWAL,
-	})
+	}
+}
 
-	return nil, nil
+// The api client asks Vault to always respond with a successful status code so the response can be parsed.
+// This derives the status code Vault would have responded with by default from the health of the node
+func getHealthStatusCode(data *api.HealthResponse) int {
+	switch {
+	case !data.Initialized:
+		return 501
+	case data.Sealed:
+		return 503
+	case data.ReplicationDRMode == "secondary":
+		return 472
+	case data.PerformanceStandby:
+		return 473
+	case data.Standby:
+		return 429
+	default:
+		return 200
+	}
 }
 
 func healthColumns() []*plugin.Column {
 	return []*plugin.Column{
+		{
+			Name:        "node_address",
+			Type:        proto.ColumnType_STRING,
+			Description: "Address of the node the health was obtained from",
+		},
+		{
+			Name:        "status_code",
+			Type:        proto.ColumnType_INT,
+			Description: "Status code of sys/health, 200 active, 429 standby, 472 DR secondary, 473 performance standby, 501 not initialized, 503 sealed",
+		},
+		{
+			Name:        "error",
+			Type:        proto.ColumnType_STRING,
+			Description: "Error obtaining the health of the node, if it could not be reached (only when node_addresses is configured)",
+		},
 		{
 			Name:        "initialized",
 			Type:        proto.ColumnType_BOOL,
@@ -0,0 +1,114 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// HostInfo Information about the host the Vault node is running on.
+// Well known values are flattened into their own fields, the complete objects remain available as well.
+type HostInfo struct {
+	Hostname          string
+	Os                string
+	Platform          string
+	PlatformVersion   string
+	KernelVersion     string
+	KernelArch        string
+	Uptime            int64
+	BootTime          int64
+	CpuCount          int64
+	CpuModel          string
+	MemoryTotal       int64
+	MemoryAvailable   int64
+	MemoryUsed        int64
+	MemoryUsedPercent float64
+	Timestamp         *time.Time
+	Cpu               []map[string]interface{}
+	CpuTimes          []map[string]interface{}
+	Disk              []map[string]interface{}
+	Host              map[string]interface{}
+	Memory            map[string]interface{}
+}
+
+func tableHostInfo() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_host_info",
+		Description: "Information about the host of the Vault node",
+		List: &plugin.ListConfig{
+			Hydrate: getHostInfo,
+		},
+		Columns: []*plugin.Column{
+			{Name: "hostname", Type: proto.ColumnType_STRING, Description: "The hostname of the host"},
+			{Name: "os", Type: proto.ColumnType_STRING, Description: "The operating system of the host, example 'linux'"},
+			{Name: "platform", Type: proto.ColumnType_STRING, Description: "The platform of the host, example 'ubuntu'"},
+			{Name: "platform_version", Type: proto.ColumnType_STRING, Description: "The version of the platform"},
+			{Name: "kernel_version", Type: proto.ColumnType_STRING, Description: "The version of the kernel"},
+			{Name: "kernel_arch", Type: proto.ColumnType_STRING, Description: "The architecture of the kernel, example 'x86_64'"},
+			{Name: "uptime", Type: proto.ColumnType_INT, Description: "The uptime of the host in seconds"},
+			{Name: "boot_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the host was booted", Transform: transform.FromField("BootTime").Transform(convertTimestamp)},
+			{Name: "cpu_count", Type: proto.ColumnType_INT, Description: "The number of CPUs of the host", Transform: transform.FromGo()},
+			{Name: "cpu_model", Type: proto.ColumnType_STRING, Description: "The model name of the CPUs"},
+			{Name: "memory_total", Type: proto.ColumnType_INT, Description: "The total memory of the host in bytes"},
+			{Name: "memory_available", Type: proto.ColumnType_INT, Description: "The available memory of the host in bytes", Transform: transform.FromGo()},
+			{Name: "memory_used", Type: proto.ColumnType_INT, Description: "The used memory of the host in bytes", Transform: transform.FromGo()},
+			{Name: "memory_used_percent", Type: proto.ColumnType_DOUBLE, Description: "The percentage of memory used", Transform: transform.FromGo()},
+			{Name: "timestamp", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the information was collected"},
+			{Name: "cpu", Type: proto.ColumnType_JSON, Description: "Array of CPU information"},
+			{Name: "cpu_times", Type: proto.ColumnType_JSON, Description: "Array of CPU time statistics"},
+			{Name: "disk", Type: proto.ColumnType_JSON, Description: "Array of disk usage information per mounted filesystem"},
+			{Name: "host", Type: proto.ColumnType_JSON, Description: "Host information"},
+			{Name: "memory", Type: proto.ColumnType_JSON, Description: "Memory usage information"},
+		},
+	}
+}
+
+func getHostInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().Read("sys/host-info")
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	info := &HostInfo{
+		Timestamp: getTime(data.Data, "timestamp"),
+		Cpu:       getMaps(data.Data, "cpu"),
+		CpuTimes:  getMaps(data.Data, "cpu_times"),
+		Disk:      getMaps(data.Data, "disk"),
+		Host:      getMap(data.Data, "host"),
+		Memory:    getMap(data.Data, "memory"),
+	}
+
+	info.Hostname = getString(info.Host, "hostname")
+	info.Os = getString(info.Host, "os")
+	info.Platform = getString(info.Host, "platform")
+	info.PlatformVersion = getString(info.Host, "platformVersion")
+	info.KernelVersion = getString(info.Host, "kernelVersion")
+	info.KernelArch = getString(info.Host, "kernelArch")
+	info.Uptime = getInt64(info.Host, "uptime")
+	info.BootTime = getInt64(info.Host, "bootTime")
+
+	info.CpuCount = int64(len(info.Cpu))
+	if len(info.Cpu) > 0 {
+		info.CpuModel = getString(info.Cpu[0], "modelName")
+	}
+
+	info.MemoryTotal = getInt64(info.Memory, "total")
+	info.MemoryAvailable = getInt64(info.Memory, "available")
+	info.MemoryUsed = getInt64(info.Memory, "used")
+	info.MemoryUsedPercent = getFloat64(info.Memory, "usedPercent")
+
+	d.StreamListItem(ctx, info)
+
+	return nil, nil
+}
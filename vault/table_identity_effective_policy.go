@@ -0,0 +1,126 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// IdentityEffectivePolicy A policy an entity ultimately inherits.
+// Source is either entity (attached directly) or group, in which case SourceID and SourceName identify the group.
+type IdentityEffectivePolicy struct {
+	EntityID   string
+	EntityName string
+	Policy     string
+	Source     string
+	SourceID   string
+	SourceName string
+}
+
+func tableIdentityEffectivePolicy() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_identity_effective_policy",
+		Description: "Policies Vault Identity Entities inherit, directly or through (nested) group membership",
+		List: &plugin.ListConfig{
+			Hydrate:    listIdentityEffectivePolicies,
+			KeyColumns: plugin.OptionalColumns([]string{"entity_id"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "entity_id", Type: proto.ColumnType_STRING, Description: "The identifier of the entity", Transform: transform.FromField("EntityID")},
+			{Name: "entity_name", Type: proto.ColumnType_STRING, Description: "The name of the entity"},
+			{Name: "policy", Type: proto.ColumnType_STRING, Description: "The name of the policy"},
+			{Name: "source", Type: proto.ColumnType_STRING, Description: "Where the policy is obtained from, entity or group"},
+			{Name: "source_id", Type: proto.ColumnType_STRING, Description: "The identifier of the entity or group the policy is attached to", Transform: transform.FromField("SourceID")},
+			{Name: "source_name", Type: proto.ColumnType_STRING, Description: "The name of the entity or group the policy is attached to"},
+		},
+	}
+}
+
+// Function called by Steampipe to populate the table.
+// Group membership is resolved by taking the groups an entity is a direct member of and walking their parent groups,
+// as members of a group inherit the policies of all groups it is (indirectly) a member of.
+func listIdentityEffectivePolicies(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	var entityIDs []string
+	quals := d.EqualsQuals
+	if quals["entity_id"] != nil {
+		entityIDs = []string{quals["entity_id"].GetStringValue()}
+	} else {
+		data, err := conn.Logical().List("identity/entity/id")
+		if err != nil {
+			return nil, err
+		}
+		entityIDs = getSecretAsStrings(data)
+	}
+
+	groups, err := listIdentityGroupDetails(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	groupsByID := map[string]*IdentityGroup{}
+	for _, group := range groups {
+		groupsByID[group.ID] = group
+	}
+
+	for _, id := range entityIDs {
+		entity, err := getIdentityEntityDetails(conn, id)
+		if err != nil {
+			return nil, err
+		}
+		if entity == nil {
+			continue
+		}
+
+		for _, policy := range entity.Policies {
+			d.StreamListItem(ctx, &IdentityEffectivePolicy{
+				EntityID:   entity.ID,
+				EntityName: entity.Name,
+				Policy:     policy,
+				Source:     "entity",
+				SourceID:   entity.ID,
+				SourceName: entity.Name,
+			})
+		}
+
+		// Direct memberships, including those of external groups which are only known to the entity itself
+		pending := append([]string{}, entity.DirectGroupIDs...)
+		for _, group := range groups {
+			if containsString(group.MemberEntityIDs, entity.ID) {
+				pending = append(pending, group.ID)
+			}
+		}
+
+		visited := map[string]bool{}
+		for len(pending) > 0 {
+			groupID := pending[0]
+			pending = pending[1:]
+
+			group := groupsByID[groupID]
+			if visited[groupID] || group == nil {
+				continue
+			}
+			visited[groupID] = true
+			pending = append(pending, group.ParentGroupIDs...)
+
+			for _, policy := range group.Policies {
+				d.StreamListItem(ctx, &IdentityEffectivePolicy{
+					EntityID:   entity.ID,
+					EntityName: entity.Name,
+					Policy:     policy,
+					Source:     "group",
+					SourceID:   group.ID,
+					SourceName: group.Name,
+				})
+			}
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,126 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type IdentityEntity struct {
+	ID                string
+	Name              string
+	Policies          []string
+	Metadata          map[string]interface{}
+	Disabled          bool
+	CreationTime      *time.Time
+	LastUpdateTime    *time.Time
+	GroupIDs          []string
+	DirectGroupIDs    []string
+	InheritedGroupIDs []string
+	MfaSecrets        map[string]interface{}
+	NamespaceID       string
+}
+
+func tableIdentityEntity() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_identity_entity",
+		Description: "Vault Identity Entities",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityEntities,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIdentityEntity,
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The identifier of the entity", Transform: transform.FromField("ID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the entity"},
+			{Name: "policies", Type: proto.ColumnType_JSON, Description: "Array of policies directly attached to the entity"},
+			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the entity"},
+			{Name: "disabled", Type: proto.ColumnType_BOOL, Description: "Indication if the entity is disabled, tokens of disabled entities cannot be used", Transform: transform.FromGo()},
+			{Name: "creation_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the entity was created"},
+			{Name: "last_update_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the entity was last updated"},
+			{Name: "group_ids", Type: proto.ColumnType_JSON, Description: "Array of identifiers of all groups the entity is a member of", Transform: transform.FromField("GroupIDs")},
+			{Name: "direct_group_ids", Type: proto.ColumnType_JSON, Description: "Array of identifiers of groups the entity is a direct member of", Transform: transform.FromField("DirectGroupIDs")},
+			{Name: "inherited_group_ids", Type: proto.ColumnType_JSON, Description: "Array of identifiers of groups the entity is a member of through nested groups", Transform: transform.FromField("InheritedGroupIDs")},
+			{Name: "mfa_secrets", Type: proto.ColumnType_JSON, Description: "MFA methods configured for the entity (secrets are not exposed by Vault)"},
+			{Name: "namespace_id", Type: proto.ColumnType_STRING, Description: "The identifier of the namespace the entity belongs to", Transform: transform.FromField("NamespaceID")},
+		},
+	}
+}
+
+// Function called by Steampipe to populate the table.
+func listIdentityEntities(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().List("identity/entity/id")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range getSecretAsStrings(data) {
+		entity, err := getIdentityEntityDetails(conn, id)
+		if err != nil {
+			return nil, err
+		}
+		if entity != nil {
+			d.StreamListItem(ctx, entity)
+		}
+	}
+
+	return nil, nil
+}
+
+// Fetches a single entity, essentially a check on if it exists
+func getIdentityEntity(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	id := quals["id"].GetStringValue()
+
+	entity, err := getIdentityEntityDetails(conn, id)
+	if err != nil {
+		return nil, err
+	}
+	if entity == nil {
+		return nil, nil
+	}
+
+	return entity, nil
+}
+
+func getIdentityEntityDetails(client *api.Client, id string) (*IdentityEntity, error) {
+	data, err := client.Logical().Read(fmt.Sprintf("identity/entity/id/%s", id))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	entity := &IdentityEntity{ID: id}
+	entity.Name = getString(data.Data, "name")
+	entity.Policies = getValues(data.Data, "policies")
+	entity.Metadata = getMap(data.Data, "metadata")
+	entity.Disabled = getBool(data.Data, "disabled")
+	entity.CreationTime = getTime(data.Data, "creation_time")
+	entity.LastUpdateTime = getTime(data.Data, "last_update_time")
+	entity.GroupIDs = getValues(data.Data, "group_ids")
+	entity.DirectGroupIDs = getValues(data.Data, "direct_group_ids")
+	entity.InheritedGroupIDs = getValues(data.Data, "inherited_group_ids")
+	entity.MfaSecrets = getMap(data.Data, "mfa_secrets")
+	entity.NamespaceID = getString(data.Data, "namespace_id")
+
+	return entity, nil
+}
@@ -0,0 +1,138 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type IdentityGroup struct {
+	ID              string
+	Name            string
+	Type            string
+	Policies        []string
+	MemberEntityIDs []string
+	MemberGroupIDs  []string
+	ParentGroupIDs  []string
+	Metadata        map[string]interface{}
+	CreationTime    *time.Time
+	LastUpdateTime  *time.Time
+	NamespaceID     string
+}
+
+func tableIdentityGroup() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_identity_group",
+		Description: "Vault Identity Groups",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityGroups,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIdentityGroup,
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The identifier of the group", Transform: transform.FromField("ID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the group"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of group, internal or external"},
+			{Name: "policies", Type: proto.ColumnType_JSON, Description: "Array of policies attached to the group"},
+			{Name: "member_entity_ids", Type: proto.ColumnType_JSON, Description: "Array of identifiers of entities which are direct members of the group", Transform: transform.FromField("MemberEntityIDs")},
+			{Name: "member_group_ids", Type: proto.ColumnType_JSON, Description: "Array of identifiers of groups which are members of the group", Transform: transform.FromField("MemberGroupIDs")},
+			{Name: "parent_group_ids", Type: proto.ColumnType_JSON, Description: "Array of identifiers of groups the group is a member of", Transform: transform.FromField("ParentGroupIDs")},
+			{Name: "metadata", Type: proto.ColumnType_JSON, Description: "Metadata associated with the group"},
+			{Name: "creation_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the group was created"},
+			{Name: "last_update_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the group was last updated"},
+			{Name: "namespace_id", Type: proto.ColumnType_STRING, Description: "The identifier of the namespace the group belongs to", Transform: transform.FromField("NamespaceID")},
+		},
+	}
+}
+
+// Function called by Steampipe to populate the table.
+func listIdentityGroups(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := listIdentityGroupDetails(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		d.StreamListItem(ctx, group)
+	}
+
+	return nil, nil
+}
+
+// Fetches a single group, essentially a check on if it exists
+func getIdentityGroup(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	id := quals["id"].GetStringValue()
+
+	group, err := getIdentityGroupDetails(conn, id)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, nil
+	}
+
+	return group, nil
+}
+
+// Fetches the details of all groups
+func listIdentityGroupDetails(client *api.Client) ([]*IdentityGroup, error) {
+	data, err := client.Logical().List("identity/group/id")
+	if err != nil {
+		return nil, err
+	}
+
+	out := []*IdentityGroup{}
+	for _, id := range getSecretAsStrings(data) {
+		group, err := getIdentityGroupDetails(client, id)
+		if err != nil {
+			return nil, err
+		}
+		if group != nil {
+			out = append(out, group)
+		}
+	}
+
+	return out, nil
+}
+
+func getIdentityGroupDetails(client *api.Client, id string) (*IdentityGroup, error) {
+	data, err := client.Logical().Read(fmt.Sprintf("identity/group/id/%s", id))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	group := &IdentityGroup{ID: id}
+	group.Name = getString(data.Data, "name")
+	group.Type = getString(data.Data, "type")
+	group.Policies = getValues(data.Data, "policies")
+	group.MemberEntityIDs = getValues(data.Data, "member_entity_ids")
+	group.MemberGroupIDs = getValues(data.Data, "member_group_ids")
+	group.ParentGroupIDs = getValues(data.Data, "parent_group_ids")
+	group.Metadata = getMap(data.Data, "metadata")
+	group.CreationTime = getTime(data.Data, "creation_time")
+	group.LastUpdateTime = getTime(data.Data, "last_update_time")
+	group.NamespaceID = getString(data.Data, "namespace_id")
+
+	return group, nil
+}
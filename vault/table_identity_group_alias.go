@@ -0,0 +1,129 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// IdentityGroupAlias The structure of a group alias, which maps an external group to a group in the authentication method.
+// MountPath and MountType are taken from the auth method matching MountAccessor, so MountPath can be joined to vault_auth.
+type IdentityGroupAlias struct {
+	ID             string
+	Name           string
+	CanonicalID    string
+	MountAccessor  string
+	MountPath      string
+	MountType      string
+	CreationTime   *time.Time
+	LastUpdateTime *time.Time
+}
+
+func tableIdentityGroupAlias() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_identity_group_alias",
+		Description: "Vault Identity Group Aliases",
+		List: &plugin.ListConfig{
+			Hydrate: listIdentityGroupAliases,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("id"),
+			Hydrate:    getIdentityGroupAlias,
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Description: "The identifier of the group alias", Transform: transform.FromField("ID")},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the group alias, as known to the authentication method"},
+			{Name: "canonical_id", Type: proto.ColumnType_STRING, Description: "The identifier of the group the alias belongs to", Transform: transform.FromField("CanonicalID")},
+			{Name: "mount_accessor", Type: proto.ColumnType_STRING, Description: "The accessor of the authentication method the alias belongs to"},
+			{Name: "mount_path", Type: proto.ColumnType_STRING, Description: "The path (mount point) of the authentication method the alias belongs to"},
+			{Name: "mount_type", Type: proto.ColumnType_STRING, Description: "The type of the authentication method the alias belongs to"},
+			{Name: "creation_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the group alias was created"},
+			{Name: "last_update_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the group alias was last updated"},
+		},
+	}
+}
+
+// Function called by Steampipe to populate the table.
+func listIdentityGroupAliases(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	auths, err := getAuthMounts(ctx, d, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().List("identity/group-alias/id")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range getSecretAsStrings(data) {
+		alias, err := getIdentityGroupAliasDetails(conn, auths, id)
+		if err != nil {
+			return nil, err
+		}
+		if alias != nil {
+			d.StreamListItem(ctx, alias)
+		}
+	}
+
+	return nil, nil
+}
+
+// Fetches a single group alias, essentially a check on if it exists
+func getIdentityGroupAlias(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	auths, err := getAuthMounts(ctx, d, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	id := quals["id"].GetStringValue()
+
+	alias, err := getIdentityGroupAliasDetails(conn, auths, id)
+	if err != nil {
+		return nil, err
+	}
+	if alias == nil {
+		return nil, nil
+	}
+
+	return alias, nil
+}
+
+func getIdentityGroupAliasDetails(client *api.Client, auths map[string]*api.AuthMount, id string) (*IdentityGroupAlias, error) {
+	data, err := client.Logical().Read(fmt.Sprintf("identity/group-alias/id/%s", id))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	alias := &IdentityGroupAlias{ID: id}
+	alias.Name = getString(data.Data, "name")
+	alias.CanonicalID = getString(data.Data, "canonical_id")
+	alias.MountAccessor = getString(data.Data, "mount_accessor")
+	alias.CreationTime = getTime(data.Data, "creation_time")
+	alias.LastUpdateTime = getTime(data.Data, "last_update_time")
+
+	if path, auth := findMountByAccessor(auths, alias.MountAccessor); auth != nil {
+		alias.MountPath = path
+		alias.MountType = auth.Type
+	}
+
+	return alias, nil
+}
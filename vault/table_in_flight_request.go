@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// InFlightRequest A request currently being handled by the Vault node
+type InFlightRequest struct {
+	RequestID           string    `json:"-"`
+	StartTime           time.Time `json:"start_time"`
+	ClientRemoteAddress string    `json:"client_remote_address"`
+	RequestPath         string    `json:"request_path"`
+	RequestMethod       string    `json:"request_method"`
+	ClientID            string    `json:"client_id"`
+}
+
+func tableInFlightRequest() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_in_flight_request",
+		Description: "Requests currently being handled by the Vault node",
+		List: &plugin.ListConfig{
+			Hydrate: listInFlightRequests,
+		},
+		Columns: []*plugin.Column{
+			{Name: "request_id", Type: proto.ColumnType_STRING, Description: "The identifier of the request", Transform: transform.FromField("RequestID")},
+			{Name: "start_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the request was received"},
+			{Name: "client_remote_address", Type: proto.ColumnType_STRING, Description: "The address of the client making the request"},
+			{Name: "request_path", Type: proto.ColumnType_STRING, Description: "The path of the request"},
+			{Name: "request_method", Type: proto.ColumnType_STRING, Description: "The HTTP method of the request"},
+			{Name: "client_id", Type: proto.ColumnType_STRING, Description: "The identifier of the client making the request", Transform: transform.FromField("ClientID")},
+		},
+	}
+}
+
+func listInFlightRequests(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	// sys/in-flight-req doesn't return a regular secret response, but a map of request id to request
+	r := conn.NewRequest("GET", "/v1/sys/in-flight-req")
+
+	resp, err := conn.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	requests := map[string]*InFlightRequest{}
+	if err := resp.DecodeJSON(&requests); err != nil {
+		return nil, err
+	}
+
+	for id, req := range requests {
+		req.RequestID = id
+		d.StreamListItem(ctx, req)
+	}
+
+	return nil, nil
+}
@@ -135,7 +135,7 @@ func listSecrets(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	}
 
 	// Queue up the mounts to explore
-	allMounts, err := conn.Sys().ListMounts()
+	allMounts, err := getMounts(ctx, d, conn)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type SysLeader struct {
+	HaEnabled                       bool
+	IsSelf                          bool
+	LeaderAddress                   string
+	LeaderClusterAddress            string
+	PerformanceStandby              bool
+	PerformanceStandbyLastRemoteWal uint64
+	ActiveTime                      time.Time
+	LastWal                         uint64
+	RaftCommittedIndex              uint64
+	RaftAppliedIndex                uint64
+}
+
+func tableSysLeader() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_sys_leader",
+		Description: "High Availability status and current leader of Vault",
+		List: &plugin.ListConfig{
+			Hydrate: getSysLeader,
+		},
+		Columns: []*plugin.Column{
+			{Name: "ha_enabled", Type: proto.ColumnType_BOOL, Description: "Is High Availability enabled", Transform: transform.FromGo()},
+			{Name: "is_self", Type: proto.ColumnType_BOOL, Description: "Is the node queried the leader", Transform: transform.FromGo()},
+			{Name: "leader_address", Type: proto.ColumnType_STRING, Description: "API address of the leader"},
+			{Name: "leader_cluster_address", Type: proto.ColumnType_STRING, Description: "Cluster address of the leader"},
+			{Name: "performance_standby", Type: proto.ColumnType_BOOL, Description: "Is the node queried a Performance Standby", Transform: transform.FromGo()},
+			{Name: "performance_standby_last_remote_wal", Type: proto.ColumnType_INT, Description: "Value of last remote WAL seen by the Performance Standby"},
+			{Name: "active_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the leader became active"},
+			{Name: "last_wal", Type: proto.ColumnType_INT, Description: "Value of last WAL"},
+			{Name: "raft_committed_index", Type: proto.ColumnType_INT, Description: "Index of the last committed Raft log entry (integrated storage only)"},
+			{Name: "raft_applied_index", Type: proto.ColumnType_INT, Description: "Index of the last applied Raft log entry (integrated storage only)"},
+		},
+	}
+}
+
+func getSysLeader(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connectUnauthenticated(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Sys().Leader()
+	if err != nil {
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, &SysLeader{
+		HaEnabled:                       data.HAEnabled,
+		IsSelf:                          data.IsSelf,
+		LeaderAddress:                   data.LeaderAddress,
+		LeaderClusterAddress:            data.LeaderClusterAddress,
+		PerformanceStandby:              data.PerfStandby,
+		PerformanceStandbyLastRemoteWal: data.PerfStandbyLastRemoteWAL,
+		ActiveTime:                      data.ActiveTime,
+		LastWal:                         data.LastWAL,
+		RaftCommittedIndex:              data.RaftCommittedIndex,
+		RaftAppliedIndex:                data.RaftAppliedIndex,
+	})
+
+	return nil, nil
+}
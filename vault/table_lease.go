@@ -0,0 +1,185 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Lease The structure of a lease.
+// Path is the lease id without the unique suffix, e.g. aws/creds/my-role/ which identifies the role that issued it
+type Lease struct {
+	LeaseID     string
+	Path        string
+	IssueTime   *time.Time
+	ExpireTime  *time.Time
+	LastRenewal *time.Time
+	Renewable   bool
+	Ttl         int64
+}
+
+func tableLease() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_lease",
+		Description: "Vault Leases of dynamic secrets and tokens",
+		List: &plugin.ListConfig{
+			Hydrate:    listLeases,
+			KeyColumns: plugin.OptionalColumns([]string{"lease_id", "prefix"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "lease_id", Type: proto.ColumnType_STRING, Description: "The identifier of the lease", Transform: transform.FromField("LeaseID")},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path the lease was issued on, example 'aws/creds/my-role/'"},
+			{Name: "prefix", Type: proto.ColumnType_STRING, Description: "Only return leases with this prefix, example 'aws/' or 'aws/creds/my-role/'", Transform: transform.FromQual("prefix")},
+			{Name: "issue_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the lease was issued"},
+			{Name: "expire_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the lease expires"},
+			{Name: "last_renewal", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the lease was last renewed, empty if it was never renewed"},
+			{Name: "renewable", Type: proto.ColumnType_BOOL, Description: "Indication if the lease is renewable", Transform: transform.FromGo()},
+			{Name: "ttl", Type: proto.ColumnType_INT, Description: "Remaining time to live of the lease in seconds", Transform: transform.FromGo()},
+		},
+	}
+}
+
+// Worker to receive lease prefixes to explore. Prefixes are explored recursively, leases found are looked up by the worker itself
+// so only prefixes (mounts and roles) are queued, never the individual leases.
+// prefixesChan is the channel that will be used to receive prefixes to still explore. This is fed by this function as well as the listLeases one
+// leasesChan is the channel that will be used to output the looked up leases
+// errsChan receives the first error, after which the remaining prefixes are skipped
+func listLeasePrefix(ctx context.Context, cancel context.CancelFunc, client *api.Client, prefixesChan chan string, leasesChan chan *Lease, errsChan chan error, wg *sync.WaitGroup) {
+	for p := range prefixesChan {
+		if ctx.Err() == nil {
+			if err := exploreLeasePrefix(client, p, prefixesChan, leasesChan, wg); err != nil {
+				select {
+				case errsChan <- err:
+				default:
+				}
+				cancel()
+			}
+		}
+		wg.Done()
+	}
+}
+
+// Lists a single lease prefix, queueing the prefixes below it and looking up the leases in it
+func exploreLeasePrefix(client *api.Client, prefix string, prefixesChan chan string, leasesChan chan *Lease, wg *sync.WaitGroup) error {
+	data, err := client.Logical().List(fmt.Sprintf("sys/leases/lookup/%s", prefix))
+	if err != nil {
+		return err
+	}
+
+	for _, k := range getSecretAsStrings(data) {
+		if strings.HasSuffix(k, "/") {
+			// We use the waitgroup as a counter. Once we've had as many wg.Done() calls as wg.Add, we've processed all trees
+			wg.Add(1)
+			prefixesChan <- prefix + k
+			continue
+		}
+
+		lease, err := getLeaseDetails(client, prefix+k)
+		if err != nil {
+			return err
+		}
+		if lease != nil {
+			leasesChan <- lease
+		}
+	}
+
+	return nil
+}
+
+// The function called by steampipe to populate the table. Will recursively walk all leases under the prefix
+func listLeases(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	if quals["lease_id"] != nil {
+		lease, err := getLeaseDetails(conn, quals["lease_id"].GetStringValue())
+		if err != nil {
+			return nil, err
+		}
+		if lease != nil {
+			d.StreamListItem(ctx, lease)
+		}
+		return nil, nil
+	}
+
+	prefix := quals["prefix"].GetStringValue()
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix = prefix + "/"
+	}
+
+	// used to determine when we've explored all prefixes
+	var wg sync.WaitGroup
+
+	// Fairly large buffer. Because prefixesChan is self feeding with recursive prefixes, it could deadlock if there isn't
+	// enough space to actually contain the prefixes left to explore. Leases themselves are never queued
+	prefixesChan := make(chan string, 50000)
+	leasesChan := make(chan *Lease, 1000)
+	errsChan := make(chan error, 1)
+	exploreCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg.Add(1)
+	prefixesChan <- prefix
+
+	// Workers for parallel requests
+	go listLeasePrefix(exploreCtx, cancel, conn, prefixesChan, leasesChan, errsChan, &wg)
+	go listLeasePrefix(exploreCtx, cancel, conn, prefixesChan, leasesChan, errsChan, &wg)
+	go listLeasePrefix(exploreCtx, cancel, conn, prefixesChan, leasesChan, errsChan, &wg)
+	go listLeasePrefix(exploreCtx, cancel, conn, prefixesChan, leasesChan, errsChan, &wg)
+
+	// Wait for the waitgroup to be done, once the waitgroup is done we'll have explored all prefixes and can close the channels
+	go func() {
+		wg.Wait()
+		close(prefixesChan)
+		close(leasesChan)
+	}()
+
+	for l := range leasesChan {
+		d.StreamListItem(ctx, l)
+	}
+
+	select {
+	case err := <-errsChan:
+		return nil, err
+	default:
+	}
+
+	return nil, nil
+}
+
+// Looks up a single lease, returns nil if the lease doesn't exist (anymore) as Vault responds with a bad request for unknown leases
+func getLeaseDetails(client *api.Client, leaseID string) (*Lease, error) {
+	data, err := client.Sys().Lookup(leaseID)
+	if hasStatusCode(err, http.StatusBadRequest) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	lease := &Lease{LeaseID: leaseID}
+	if i := strings.LastIndex(leaseID, "/"); i >= 0 {
+		lease.Path = leaseID[:i+1]
+	}
+	lease.IssueTime = getTime(data.Data, "issue_time")
+	lease.ExpireTime = getTime(data.Data, "expire_time")
+	lease.LastRenewal = getTime(data.Data, "last_renewal")
+	lease.Renewable = getBool(data.Data, "renewable")
+	lease.Ttl = getInt64(data.Data, "ttl")
+
+	return lease, nil
+}
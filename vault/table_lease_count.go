@@ -0,0 +1,258 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// LeaseCount The number of leases of the cluster (total), a mount or a single path (e.g. aws/creds/my-role/).
+// Total and mount counts are obtained without enumerating leases, path counts are only returned when a prefix is supplied.
+type LeaseCount struct {
+	Type                  string
+	Path                  string
+	Mount                 string
+	MountAccessor         string
+	LeaseCount            *int64
+	IrrevocableLeaseCount *int64
+}
+
+func tableLeaseCount() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_lease_count",
+		Description: "Vault Lease counts of the cluster, per mount and per role",
+		List: &plugin.ListConfig{
+			Hydrate:    listLeaseCounts,
+			KeyColumns: plugin.OptionalColumns([]string{"prefix"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of count, total, mount or path (path only when prefix is supplied)"},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path the leases were issued on, example 'aws/creds/my-role/' (path rows only)"},
+			{Name: "mount", Type: proto.ColumnType_STRING, Description: "The mount the leases were issued by, example 'aws/' or 'auth/approle/' (mount and path rows only)"},
+			{Name: "mount_accessor", Type: proto.ColumnType_STRING, Description: "The accessor of the mount the leases were issued by (mount rows only)"},
+			{Name: "prefix", Type: proto.ColumnType_STRING, Description: "Count the leases per path with this prefix, example 'aws/'. Lists every lease id under the prefix", Transform: transform.FromQual("prefix")},
+			{Name: "lease_count", Type: proto.ColumnType_INT, Description: "The number of leases, taken from the expire.num_leases metric for the total (total and path rows only)"},
+			{Name: "irrevocable_lease_count", Type: proto.ColumnType_INT, Description: "The number of irrevocable leases (total and mount rows only)"},
+		},
+	}
+}
+
+// The function called by steampipe to populate the table. Totals and mount counts are always returned, the leases under
+// the prefix are only counted (by listing them) when a prefix is supplied
+func listLeaseCounts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := d.EqualsQuals["prefix"].GetStringValue()
+	if prefix == "" {
+		return nil, streamLeaseCountTotals(ctx, d, conn)
+	}
+
+	if !strings.HasSuffix(prefix, "/") {
+		prefix = prefix + "/"
+	}
+
+	return nil, streamLeaseCountPaths(ctx, d, conn, prefix)
+}
+
+// Streams the total and per mount counts, obtained from the metrics and sys/leases/count without enumerating any leases
+func streamLeaseCountTotals(ctx context.Context, d *plugin.QueryData, client *api.Client) error {
+	metrics, err := getMetrics(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	// Unless telemetry has disable_hostname set the hostname is part of the gauge name (vault.<hostname>.expire.num_leases).
+	// The gauge is reported per namespace on Enterprise, the total is the sum
+	total := &LeaseCount{Type: "total"}
+	for _, g := range metrics.Gauges {
+		if strings.HasSuffix(g.Name, ".expire.num_leases") {
+			count := int64(g.Value)
+			if total.LeaseCount != nil {
+				count += *total.LeaseCount
+			}
+			total.LeaseCount = &count
+		}
+	}
+
+	// sys/leases/count only supports irrevocable leases and is not available on older versions of Vault
+	data, err := client.Logical().ReadWithData("sys/leases/count", map[string][]string{"type": {"irrevocable"}})
+	if err != nil {
+		return err
+	}
+
+	var mounts []*LeaseCount
+	if data != nil {
+		count := getInt64(data.Data, "lease_count")
+		total.IrrevocableLeaseCount = &count
+
+		// The counts are keyed by mount accessor
+		perMount := getMap(data.Data, "counts")
+		for accessor := range perMount {
+			mountCount := getInt64(perMount, accessor)
+			mounts = append(mounts, &LeaseCount{Type: "mount", MountAccessor: accessor, IrrevocableLeaseCount: &mountCount})
+		}
+	}
+
+	if len(mounts) > 0 {
+		engines, err := getMounts(ctx, d, client)
+		if err != nil {
+			return err
+		}
+		auths, err := getAuthMounts(ctx, d, client)
+		if err != nil {
+			return err
+		}
+
+		// Mounts of other namespaces aren't listed, their path is left empty
+		for _, m := range mounts {
+			if path, mount := findMountByAccessor(engines, m.MountAccessor); mount != nil {
+				m.Mount = path
+			} else if path, mount := findMountByAccessor(auths, m.MountAccessor); mount != nil {
+				m.Mount = "auth/" + path
+			}
+		}
+	}
+
+	d.StreamListItem(ctx, total)
+	for _, m := range mounts {
+		d.StreamListItem(ctx, m)
+	}
+
+	return nil
+}
+
+// Streams the number of leases per path under the prefix. Leases are not looked up, but every lease id is listed
+func streamLeaseCountPaths(ctx context.Context, d *plugin.QueryData, client *api.Client, prefix string) error {
+	mounts, err := getLeaseMounts(ctx, d, client)
+	if err != nil {
+		return err
+	}
+
+	// used to determine when we've explored all prefixes
+	var wg sync.WaitGroup
+
+	// Fairly large buffers. Because prefixesChan is self feeding with recursive prefixes, it could deadlock if there isn't
+	// enough space to actually contain the prefixes left to explore. Only prefixes are queued, never the leases
+	prefixesChan := make(chan string, 50000)
+	countsChan := make(chan *LeaseCount, 50000)
+	errsChan := make(chan error, 1)
+	exploreCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	wg.Add(1)
+	prefixesChan <- prefix
+
+	// Workers for parallel requests
+	go countLeasePrefix(exploreCtx, cancel, client, prefixesChan, countsChan, errsChan, &wg)
+	go countLeasePrefix(exploreCtx, cancel, client, prefixesChan, countsChan, errsChan, &wg)
+	go countLeasePrefix(exploreCtx, cancel, client, prefixesChan, countsChan, errsChan, &wg)
+	go countLeasePrefix(exploreCtx, cancel, client, prefixesChan, countsChan, errsChan, &wg)
+
+	// Wait for the waitgroup to be done, once the waitgroup is done we'll have explored all prefixes and can close the channels
+	go func() {
+		wg.Wait()
+		close(prefixesChan)
+		close(countsChan)
+	}()
+
+	for c := range countsChan {
+		c.Mount = getLeaseMount(mounts, c.Path)
+		d.StreamListItem(ctx, c)
+	}
+
+	select {
+	case err := <-errsChan:
+		return err
+	default:
+	}
+
+	return nil
+}
+
+// Worker to receive lease prefixes to count. Prefixes are explored recursively, leases are only counted and not looked up
+// prefixesChan is the channel that will be used to receive prefixes to still explore. This is fed by this function as well as the streamLeaseCountPaths one
+// countsChan is the channel that will be used to output the counts of paths containing leases
+// errsChan receives the first error, after which the remaining prefixes are skipped
+func countLeasePrefix(ctx context.Context, cancel context.CancelFunc, client *api.Client, prefixesChan chan string, countsChan chan *LeaseCount, errsChan chan error, wg *sync.WaitGroup) {
+	for p := range prefixesChan {
+		if ctx.Err() == nil {
+			if err := countLeasePrefixEntries(client, p, prefixesChan, countsChan, wg); err != nil {
+				select {
+				case errsChan <- err:
+				default:
+				}
+				cancel()
+			}
+		}
+		wg.Done()
+	}
+}
+
+// Lists a single lease prefix, queueing the prefixes below it and counting the leases in it
+func countLeasePrefixEntries(client *api.Client, prefix string, prefixesChan chan string, countsChan chan *LeaseCount, wg *sync.WaitGroup) error {
+	data, err := client.Logical().List(fmt.Sprintf("sys/leases/lookup/%s", prefix))
+	if err != nil {
+		return err
+	}
+
+	var count int64
+	for _, k := range getSecretAsStrings(data) {
+		if strings.HasSuffix(k, "/") {
+			// We use the waitgroup as a counter. Once we've had as many wg.Done() calls as wg.Add, we've processed all trees
+			wg.Add(1)
+			prefixesChan <- prefix + k
+		} else {
+			count++
+		}
+	}
+
+	if count > 0 {
+		countsChan <- &LeaseCount{Type: "path", Path: prefix, LeaseCount: &count}
+	}
+
+	return nil
+}
+
+// Obtains the paths of all secret engines and auth methods, as they appear in lease ids
+func getLeaseMounts(ctx context.Context, d *plugin.QueryData, client *api.Client) ([]string, error) {
+	var paths []string
+
+	mounts, err := getMounts(ctx, d, client)
+	if err != nil {
+		return nil, err
+	}
+	for path := range mounts {
+		paths = append(paths, path)
+	}
+
+	auths, err := getAuthMounts(ctx, d, client)
+	if err != nil {
+		return nil, err
+	}
+	for path := range auths {
+		paths = append(paths, "auth/"+path)
+	}
+
+	return paths, nil
+}
+
+// Obtains the mount a lease path belongs to, the longest matching mount path wins
+func getLeaseMount(mounts []string, path string) string {
+	var mount string
+	for _, m := range mounts {
+		if strings.HasPrefix(path, m) && len(m) > len(mount) {
+			mount = m
+		}
+	}
+
+	return mount
+}
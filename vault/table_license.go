@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// License The Enterprise license in use by the cluster
+type License struct {
+	LicenseID               string
+	CustomerID              string
+	InstallationID          string
+	Product                 string
+	IssueTime               *time.Time
+	StartTime               *time.Time
+	ExpirationTime          *time.Time
+	TerminationTime         *time.Time
+	Terminated              bool
+	Features                []string
+	PerformanceStandbyCount int64
+	AutoloadingUsed         bool
+}
+
+func tableLicense() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_license",
+		Description: "Vault Enterprise License",
+		List: &plugin.ListConfig{
+			Hydrate: getLicense,
+		},
+		Columns: []*plugin.Column{
+			{Name: "license_id", Type: proto.ColumnType_STRING, Description: "The identifier of the license", Transform: transform.FromField("LicenseID")},
+			{Name: "customer_id", Type: proto.ColumnType_STRING, Description: "The identifier of the customer the license was issued to", Transform: transform.FromField("CustomerID")},
+			{Name: "installation_id", Type: proto.ColumnType_STRING, Description: "The installation the license is restricted to, '*' for any installation", Transform: transform.FromField("InstallationID")},
+			{Name: "product", Type: proto.ColumnType_STRING, Description: "The product the license is for"},
+			{Name: "issue_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the license was issued"},
+			{Name: "start_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the license became valid"},
+			{Name: "expiration_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the license expires"},
+			{Name: "termination_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time Vault stops working with the license"},
+			{Name: "terminated", Type: proto.ColumnType_BOOL, Description: "Indication if the termination time of the license has passed", Transform: transform.FromGo()},
+			{Name: "features", Type: proto.ColumnType_JSON, Description: "Array of features enabled by the license"},
+			{Name: "performance_standby_count", Type: proto.ColumnType_INT, Description: "The number of performance standby nodes allowed by the license", Transform: transform.FromGo()},
+			{Name: "autoloading_used", Type: proto.ColumnType_BOOL, Description: "Indication if the license was autoloaded from the configuration or environment", Transform: transform.FromGo()},
+		},
+	}
+}
+
+func getLicense(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().Read("sys/license/status")
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	// Newer versions of Vault only support autoloaded licenses, older versions return the license directly
+	license := getMap(data.Data, "autoloaded")
+	if license == nil {
+		license = data.Data
+	}
+
+	l := &License{
+		LicenseID:               getString(license, "license_id"),
+		CustomerID:              getString(license, "customer_id"),
+		InstallationID:          getString(license, "installation_id"),
+		Product:                 getString(license, "product"),
+		IssueTime:               getTime(license, "issue_time"),
+		StartTime:               getTime(license, "start_time"),
+		ExpirationTime:          getTime(license, "expiration_time"),
+		TerminationTime:         getTime(license, "termination_time"),
+		Features:                getValues(license, "features"),
+		PerformanceStandbyCount: getInt64(license, "performance_standby_count"),
+		AutoloadingUsed:         getBool(data.Data, "autoloading_used"),
+	}
+	l.Terminated = l.TerminationTime != nil && l.TerminationTime.Before(time.Now())
+
+	d.StreamListItem(ctx, l)
+
+	return nil, nil
+}
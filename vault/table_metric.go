@@ -0,0 +1,144 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Metric A single telemetry metric, a gauge, counter or sample.
+// Value is only set for gauges, the aggregated values only for counters and samples.
+type Metric struct {
+	Name   string
+	Type   string
+	Labels map[string]string
+	Value  float64
+	Count  int64
+	Rate   float64
+	Sum    float64
+	Min    float64
+	Max    float64
+	Mean   float64
+	Stddev float64
+}
+
+// The structure of the JSON returned by sys/metrics, it is not wrapped in a data object like other endpoints
+type metricsResponse struct {
+	Gauges []struct {
+		Name   string            `json:"Name"`
+		Value  float64           `json:"Value"`
+		Labels map[string]string `json:"Labels"`
+	} `json:"Gauges"`
+	Counters []metricsSummary `json:"Counters"`
+	Samples  []metricsSummary `json:"Samples"`
+}
+
+type metricsSummary struct {
+	Name   string            `json:"Name"`
+	Count  int64             `json:"Count"`
+	Rate   float64           `json:"Rate"`
+	Sum    float64           `json:"Sum"`
+	Min    float64           `json:"Min"`
+	Max    float64           `json:"Max"`
+	Mean   float64           `json:"Mean"`
+	Stddev float64           `json:"Stddev"`
+	Labels map[string]string `json:"Labels"`
+}
+
+func tableMetric() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_metric",
+		Description: "Vault Telemetry Metrics",
+		List: &plugin.ListConfig{
+			Hydrate:    listMetrics,
+			KeyColumns: plugin.OptionalColumns([]string{"name", "type"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the metric, example 'vault.core.handle_request'. Gauges include the hostname unless disable_hostname is set, example 'vault.<hostname>.expire.num_leases'"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of metric, gauge, counter or sample"},
+			{Name: "labels", Type: proto.ColumnType_JSON, Description: "The labels of the metric"},
+			{Name: "value", Type: proto.ColumnType_DOUBLE, Description: "The value of the gauge (gauges only)", Transform: transform.FromGo()},
+			{Name: "count", Type: proto.ColumnType_INT, Description: "The number of times the metric was recorded in the interval (counters and samples only)", Transform: transform.FromGo()},
+			{Name: "rate", Type: proto.ColumnType_DOUBLE, Description: "The rate per second of the metric in the interval (counters and samples only)", Transform: transform.FromGo()},
+			{Name: "sum", Type: proto.ColumnType_DOUBLE, Description: "The sum of the recorded values in the interval (counters and samples only)", Transform: transform.FromGo()},
+			{Name: "min", Type: proto.ColumnType_DOUBLE, Description: "The minimum recorded value in the interval (counters and samples only)", Transform: transform.FromGo()},
+			{Name: "max", Type: proto.ColumnType_DOUBLE, Description: "The maximum recorded value in the interval (counters and samples only)", Transform: transform.FromGo()},
+			{Name: "mean", Type: proto.ColumnType_DOUBLE, Description: "The mean of the recorded values in the interval (counters and samples only)", Transform: transform.FromGo()},
+			{Name: "stddev", Type: proto.ColumnType_DOUBLE, Description: "The standard deviation of the recorded values in the interval (counters and samples only)", Transform: transform.FromGo()},
+		},
+	}
+}
+
+func listMetrics(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := getMetrics(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*Metric
+	for _, g := range metrics.Gauges {
+		rows = append(rows, &Metric{Name: g.Name, Type: "gauge", Labels: g.Labels, Value: g.Value})
+	}
+	for _, c := range metrics.Counters {
+		rows = append(rows, newMetric("counter", c))
+	}
+	for _, s := range metrics.Samples {
+		rows = append(rows, newMetric("sample", s))
+	}
+
+	name := d.EqualsQuals["name"].GetStringValue()
+	metricType := d.EqualsQuals["type"].GetStringValue()
+	for _, m := range rows {
+		if (name != "" && m.Name != name) || (metricType != "" && m.Type != metricType) {
+			continue
+		}
+		d.StreamListItem(ctx, m)
+	}
+
+	return nil, nil
+}
+
+func newMetric(metricType string, s metricsSummary) *Metric {
+	return &Metric{
+		Name:   s.Name,
+		Type:   metricType,
+		Labels: s.Labels,
+		Count:  s.Count,
+		Rate:   s.Rate,
+		Sum:    s.Sum,
+		Min:    s.Min,
+		Max:    s.Max,
+		Mean:   s.Mean,
+		Stddev: s.Stddev,
+	}
+}
+
+// Obtains the current telemetry metrics of the node
+func getMetrics(ctx context.Context, client *api.Client) (*metricsResponse, error) {
+	// sys/metrics doesn't return a regular secret response, so the raw response is decoded.
+	// Without a format Vault responds with JSON, the only other supported format is prometheus
+	r := client.NewRequest("GET", "/v1/sys/metrics")
+
+	resp, err := client.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics metricsResponse
+	if err := resp.DecodeJSON(&metrics); err != nil {
+		return nil, err
+	}
+
+	return &metrics, nil
+}
@@ -0,0 +1,157 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// MountTune The tuning configuration of a secrets engine or authentication method.
+// MountClass identifies whether the mount is a secrets engine (secret) or an authentication method (auth).
+type MountTune struct {
+	Path                      string
+	MountClass                string
+	Type                      string
+	Accessor                  string
+	DefaultLeaseTtl           int64
+	MaxLeaseTtl               int64
+	ForceNoCache              bool
+	AuditNonHmacRequestKeys   []string
+	AuditNonHmacResponseKeys  []string
+	ListingVisibility         string
+	PassthroughRequestHeaders []string
+	AllowedResponseHeaders    []string
+	AllowedManagedKeys        []string
+	TokenType                 string
+	UserLockoutConfig         map[string]interface{}
+	PluginVersion             string
+}
+
+func tableMountTune() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_mount_tune",
+		Description: "Vault Tuning configuration of secrets engines and authentication methods",
+		List: &plugin.ListConfig{
+			Hydrate:    listMountTunes,
+			KeyColumns: plugin.OptionalColumns([]string{"path", "mount_class"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path (mount point) of the secrets engine or authentication method, including the trailing slash, example 'secret/'"},
+			{Name: "mount_class", Type: proto.ColumnType_STRING, Description: "The class of mount, secret for secrets engines and auth for authentication methods"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the secrets engine or authentication method"},
+			{Name: "accessor", Type: proto.ColumnType_STRING, Description: "The accessor of the secrets engine or authentication method"},
+			{Name: "default_lease_ttl", Type: proto.ColumnType_INT, Description: "The default lease duration in seconds"},
+			{Name: "max_lease_ttl", Type: proto.ColumnType_INT, Description: "The maximum lease duration in seconds"},
+			{Name: "force_no_cache", Type: proto.ColumnType_BOOL, Description: "Indication if caching is disabled for the mount", Transform: transform.FromGo()},
+			{Name: "audit_non_hmac_request_keys", Type: proto.ColumnType_JSON, Description: "Array of request keys which are not HMAC'd by audit devices"},
+			{Name: "audit_non_hmac_response_keys", Type: proto.ColumnType_JSON, Description: "Array of response keys which are not HMAC'd by audit devices"},
+			{Name: "listing_visibility", Type: proto.ColumnType_STRING, Description: "Indication if the mount is listed in the UI, unauth or hidden"},
+			{Name: "passthrough_request_headers", Type: proto.ColumnType_JSON, Description: "Array of request headers passed through to the plugin"},
+			{Name: "allowed_response_headers", Type: proto.ColumnType_JSON, Description: "Array of response headers the plugin is allowed to set"},
+			{Name: "allowed_managed_keys", Type: proto.ColumnType_JSON, Description: "Array of managed keys the mount is allowed to use"},
+			{Name: "token_type", Type: proto.ColumnType_STRING, Description: "The type of tokens issued (authentication methods only)"},
+			{Name: "user_lockout_config", Type: proto.ColumnType_JSON, Description: "The user lockout configuration (authentication methods only)"},
+			{Name: "plugin_version", Type: proto.ColumnType_STRING, Description: "The version of the plugin used by the mount"},
+		},
+	}
+}
+
+// The function called by steampipe to populate the table. Reads the tuning configuration of every secrets engine and authentication method
+func listMountTunes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	path := quals["path"].GetStringValue()
+	mountClass := quals["mount_class"].GetStringValue()
+
+	if mountClass == "" || mountClass == "secret" {
+		mounts, err := getMounts(ctx, d, conn)
+		if err != nil {
+			return nil, err
+		}
+
+		for p, mount := range mounts {
+			if path != "" && p != path {
+				continue
+			}
+
+			tune, err := getMountTune(conn, fmt.Sprintf("sys/mounts/%stune", p))
+			if isPermissionDenied(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if tune != nil {
+				tune.Path = p
+				tune.MountClass = "secret"
+				tune.Type = mount.Type
+				tune.Accessor = mount.Accessor
+				d.StreamListItem(ctx, tune)
+			}
+		}
+	}
+
+	if mountClass == "" || mountClass == "auth" {
+		auths, err := getAuthMounts(ctx, d, conn)
+		if err != nil {
+			return nil, err
+		}
+
+		for p, auth := range auths {
+			if path != "" && p != path {
+				continue
+			}
+
+			tune, err := getMountTune(conn, fmt.Sprintf("sys/auth/%stune", p))
+			if isPermissionDenied(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if tune != nil {
+				tune.Path = p
+				tune.MountClass = "auth"
+				tune.Type = auth.Type
+				tune.Accessor = auth.Accessor
+				d.StreamListItem(ctx, tune)
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// Reads the tuning configuration of a mount, sys/mounts/<path>/tune and sys/auth/<path>/tune return the same structure
+func getMountTune(client *api.Client, tunePath string) (*MountTune, error) {
+	data, err := client.Logical().Read(tunePath)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	return &MountTune{
+		DefaultLeaseTtl:           getInt64(data.Data, "default_lease_ttl"),
+		MaxLeaseTtl:               getInt64(data.Data, "max_lease_ttl"),
+		ForceNoCache:              getBool(data.Data, "force_no_cache"),
+		AuditNonHmacRequestKeys:   getValues(data.Data, "audit_non_hmac_request_keys"),
+		AuditNonHmacResponseKeys:  getValues(data.Data, "audit_non_hmac_response_keys"),
+		ListingVisibility:         getString(data.Data, "listing_visibility"),
+		PassthroughRequestHeaders: getValues(data.Data, "passthrough_request_headers"),
+		AllowedResponseHeaders:    getValues(data.Data, "allowed_response_headers"),
+		AllowedManagedKeys:        getValues(data.Data, "allowed_managed_keys"),
+		TokenType:                 getString(data.Data, "token_type"),
+		UserLockoutConfig:         getMap(data.Data, "user_lockout_config"),
+		PluginVersion:             getString(data.Data, "plugin_version"),
+	}, nil
+}
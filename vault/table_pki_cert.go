@@ -44,7 +44,7 @@ func listCerts(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData)
 	if err != nil {
 		return nil, err
 	}
-	allMounts, err := conn.Sys().ListMounts()
+	allMounts, err := getMounts(ctx, d, conn)
 	if err != nil {
 		return nil, err
 	}
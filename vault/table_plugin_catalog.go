@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// PluginCatalogEntry A plugin registered in the catalog.
+// CatalogKey identifies the entry and matches the plugin_catalog_key of the mounts running it.
+type PluginCatalogEntry struct {
+	Name              string
+	Type              string
+	Version           string
+	Builtin           bool
+	Sha256            string
+	Command           string
+	Args              []string
+	DeprecationStatus string
+	CatalogKey        string
+}
+
+func tablePluginCatalog() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_plugin_catalog",
+		Description: "Vault Plugin Catalog",
+		List: &plugin.ListConfig{
+			Hydrate: listPluginCatalog,
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the plugin"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of plugin, auth, secret or database"},
+			{Name: "version", Type: proto.ColumnType_STRING, Description: "The semantic version of the plugin"},
+			{Name: "builtin", Type: proto.ColumnType_BOOL, Description: "Is the plugin built into Vault", Transform: transform.FromGo()},
+			{Name: "sha256", Type: proto.ColumnType_STRING, Description: "The SHA256 sum of the plugin binary (external plugins only)"},
+			{Name: "command", Type: proto.ColumnType_STRING, Description: "The command used to run the plugin (external plugins only)"},
+			{Name: "args", Type: proto.ColumnType_JSON, Description: "Array of arguments passed to the plugin command (external plugins only)"},
+			{Name: "deprecation_status", Type: proto.ColumnType_STRING, Description: "Deprecation status of the plugin"},
+			{Name: "catalog_key", Type: proto.ColumnType_STRING, Description: "Key identifying the catalog entry as type/name/version, join on plugin_catalog_key of vault_engine or vault_auth"},
+		},
+	}
+}
+
+func listPluginCatalog(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Sys().ListPlugins(&api.ListPluginsInput{})
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	// Older versions of Vault don't return any details, only the names of the plugins
+	details := data.Details
+	if len(details) == 0 {
+		for pluginType, names := range data.PluginsByType {
+			for _, name := range names {
+				details = append(details, api.PluginDetails{Type: pluginType.String(), Name: name})
+			}
+		}
+	}
+
+	for _, p := range details {
+		entry := &PluginCatalogEntry{
+			Name:              p.Name,
+			Type:              p.Type,
+			Version:           p.Version,
+			Builtin:           p.Builtin,
+			DeprecationStatus: p.DeprecationStatus,
+			CatalogKey:        getPluginCatalogKey(p.Type, p.Name, p.Version),
+		}
+
+		// The command & checksum are only available by reading the individual plugin
+		if !p.Builtin {
+			params := map[string][]string{}
+			if p.Version != "" {
+				params["version"] = []string{p.Version}
+			}
+
+			pluginData, err := conn.Logical().ReadWithData(fmt.Sprintf("sys/plugins/catalog/%s/%s", p.Type, p.Name), params)
+			if err != nil {
+				return nil, err
+			}
+			if pluginData != nil {
+				entry.Sha256 = getString(pluginData.Data, "sha256")
+				entry.Command = getString(pluginData.Data, "command")
+				entry.Args = getValues(pluginData.Data, "args")
+			}
+		}
+
+		d.StreamListItem(ctx, entry)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type QuotaConfig struct {
+	EnableRateLimitAuditLogging    bool
+	EnableRateLimitResponseHeaders bool
+	RateLimitExemptPaths           []string
+	AbsoluteRateLimitExemptPaths   []string
+}
+
+func tableQuotaConfig() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_quota_config",
+		Description: "Vault global quota configuration",
+		List: &plugin.ListConfig{
+			Hydrate: getQuotaConfig,
+		},
+		Columns: []*plugin.Column{
+			{Name: "enable_rate_limit_audit_logging", Type: proto.ColumnType_BOOL, Description: "Are requests rejected by rate limit quotas audit logged", Transform: transform.FromGo()},
+			{Name: "enable_rate_limit_response_headers", Type: proto.ColumnType_BOOL, Description: "Are rate limit headers added to responses", Transform: transform.FromGo()},
+			{Name: "rate_limit_exempt_paths", Type: proto.ColumnType_JSON, Description: "Array of paths exempt from rate limit quotas"},
+			{Name: "absolute_rate_limit_exempt_paths", Type: proto.ColumnType_JSON, Description: "Array of absolute paths (across all namespaces) exempt from rate limit quotas"},
+		},
+	}
+}
+
+func getQuotaConfig(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().Read("sys/quotas/config")
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	d.StreamListItem(ctx, &QuotaConfig{
+		EnableRateLimitAuditLogging:    getBool(data.Data, "enable_rate_limit_audit_logging"),
+		EnableRateLimitResponseHeaders: getBool(data.Data, "enable_rate_limit_response_headers"),
+		RateLimitExemptPaths:           getValues(data.Data, "rate_limit_exempt_paths"),
+		AbsoluteRateLimitExemptPaths:   getValues(data.Data, "absolute_rate_limit_exempt_paths"),
+	})
+
+	return nil, nil
+}
@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type LeaseCountQuota struct {
+	Name        string
+	Path        string
+	Namespace   string
+	Mount       string
+	Role        string
+	MaxLeases   int64
+	Inheritable bool
+}
+
+func tableQuotaLeaseCount() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_quota_lease_count",
+		Description: "Vault Lease Count Quotas",
+		List: &plugin.ListConfig{
+			Hydrate: listLeaseCountQuotas,
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the quota"},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path (namespace and/or mount) the quota applies to, empty for a global quota"},
+			{Name: "namespace", Type: proto.ColumnType_STRING, Description: "The namespace the quota applies to, empty for the root namespace"},
+			{Name: "mount", Type: proto.ColumnType_STRING, Description: "The mount the quota applies to, empty if the quota applies to the entire namespace"},
+			{Name: "role", Type: proto.ColumnType_STRING, Description: "The login role the quota applies to"},
+			{Name: "max_leases", Type: proto.ColumnType_INT, Description: "Maximum number of leases allowed"},
+			{Name: "inheritable", Type: proto.ColumnType_BOOL, Description: "Does the quota apply to child namespaces", Transform: transform.FromGo()},
+		},
+	}
+}
+
+func listLeaseCountQuotas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas, err := listQuotaDetails(conn, "lease-count")
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := listNamespaces(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, quota := range quotas {
+		namespace, mount := splitQuotaPath(namespaces, getString(quota, "path"))
+		d.StreamListItem(ctx, &LeaseCountQuota{
+			Name:        name,
+			Path:        getString(quota, "path"),
+			Namespace:   namespace,
+			Mount:       mount,
+			Role:        getString(quota, "role"),
+			MaxLeases:   getInt64(quota, "max_leases"),
+			Inheritable: getBool(quota, "inheritable"),
+		})
+	}
+
+	return nil, nil
+}
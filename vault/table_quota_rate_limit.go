@@ -0,0 +1,139 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type RateLimitQuota struct {
+	Name          string
+	Path          string
+	Namespace     string
+	Mount         string
+	Role          string
+	Rate          float64
+	Interval      int64
+	BlockInterval int64
+	Inheritable   bool
+}
+
+func tableQuotaRateLimit() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_quota_rate_limit",
+		Description: "Vault Rate Limit Quotas",
+		List: &plugin.ListConfig{
+			Hydrate: listRateLimitQuotas,
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the quota"},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path (namespace and/or mount) the quota applies to, empty for a global quota"},
+			{Name: "namespace", Type: proto.ColumnType_STRING, Description: "The namespace the quota applies to, empty for the root namespace"},
+			{Name: "mount", Type: proto.ColumnType_STRING, Description: "The mount the quota applies to, empty if the quota applies to the entire namespace"},
+			{Name: "role", Type: proto.ColumnType_STRING, Description: "The login role the quota applies to"},
+			{Name: "rate", Type: proto.ColumnType_DOUBLE, Description: "Maximum number of requests allowed per interval"},
+			{Name: "interval", Type: proto.ColumnType_INT, Description: "Duration in seconds the rate applies to"},
+			{Name: "block_interval", Type: proto.ColumnType_INT, Description: "Duration in seconds clients are blocked for after exceeding the rate (0 if not blocked)", Transform: transform.FromGo()},
+			{Name: "inheritable", Type: proto.ColumnType_BOOL, Description: "Does the quota apply to child namespaces", Transform: transform.FromGo()},
+		},
+	}
+}
+
+func listRateLimitQuotas(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quotas, err := listQuotaDetails(conn, "rate-limit")
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, err := listNamespaces(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, quota := range quotas {
+		namespace, mount := splitQuotaPath(namespaces, getString(quota, "path"))
+		d.StreamListItem(ctx, &RateLimitQuota{
+			Name:          name,
+			Path:          getString(quota, "path"),
+			Namespace:     namespace,
+			Mount:         mount,
+			Role:          getString(quota, "role"),
+			Rate:          getFloat64(quota, "rate"),
+			Interval:      getInt64(quota, "interval"),
+			BlockInterval: getInt64(quota, "block_interval"),
+			Inheritable:   getBool(quota, "inheritable"),
+		})
+	}
+
+	return nil, nil
+}
+
+// Fetches the details of all quotas of a type (rate-limit or lease-count) by name
+func listQuotaDetails(client *api.Client, quotaType string) (map[string]map[string]interface{}, error) {
+	data, err := client.Logical().List(fmt.Sprintf("sys/quotas/%s", quotaType))
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]map[string]interface{}{}
+	for _, name := range getSecretAsStrings(data) {
+		quota, err := client.Logical().Read(fmt.Sprintf("sys/quotas/%s/%s", quotaType, name))
+		if err != nil {
+			return nil, err
+		}
+		if quota != nil {
+			out[name] = quota.Data
+		}
+	}
+
+	return out, nil
+}
+
+// Lists the paths of all (nested) namespaces, e.g. ns1/ and ns1/ns2/. Namespaces are a Vault Enterprise feature, other versions
+// return none. Tokens which aren't allowed to list namespaces return none as well, quota paths are then not split
+func listNamespaces(client *api.Client) ([]string, error) {
+	var namespaces []string
+	queue := []string{""}
+
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+
+		data, err := client.WithNamespace(parent).Logical().List("sys/namespaces")
+		if isPermissionDenied(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, key := range getSecretAsStrings(data) {
+			namespaces = append(namespaces, parent+key)
+			queue = append(queue, parent+key)
+		}
+	}
+
+	return namespaces, nil
+}
+
+// Splits the path of a quota into the namespace and mount it applies to, the longest matching namespace wins
+func splitQuotaPath(namespaces []string, path string) (string, string) {
+	var namespace string
+	for _, ns := range namespaces {
+		if strings.HasPrefix(path, ns) && len(ns) > len(namespace) {
+			namespace = ns
+		}
+	}
+
+	return namespace, strings.TrimPrefix(path, namespace)
+}
@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// RaftAutopilotServer The autopilot state of a single server.
+// Healthy, FailureTolerance and Leader describe the cluster as a whole and are the same for every server.
+type RaftAutopilotServer struct {
+	Healthy          bool
+	FailureTolerance int
+	Leader           string
+	ServerID         string
+	ServerName       string
+	ServerAddress    string
+	ServerHealthy    bool
+	ServerStatus     string
+	NodeStatus       string
+	LastContact      string
+	LastTerm         uint64
+	LastIndex        uint64
+	StableSince      *time.Time
+	Version          string
+	RedundancyZone   string
+}
+
+func tableRaftAutopilot() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_raft_autopilot",
+		Description: "Vault Raft (integrated storage) autopilot state per server",
+		List: &plugin.ListConfig{
+			Hydrate: listRaftAutopilot,
+		},
+		Columns: []*plugin.Column{
+			{Name: "healthy", Type: proto.ColumnType_BOOL, Description: "Is the cluster healthy", Transform: transform.FromGo()},
+			{Name: "failure_tolerance", Type: proto.ColumnType_INT, Description: "Number of voters the cluster can lose while retaining quorum", Transform: transform.FromGo()},
+			{Name: "leader", Type: proto.ColumnType_STRING, Description: "The identifier of the leader"},
+			{Name: "server_id", Type: proto.ColumnType_STRING, Description: "The identifier of the server", Transform: transform.FromField("ServerID")},
+			{Name: "server_name", Type: proto.ColumnType_STRING, Description: "The name of the server"},
+			{Name: "server_address", Type: proto.ColumnType_STRING, Description: "The cluster address of the server"},
+			{Name: "server_healthy", Type: proto.ColumnType_BOOL, Description: "Is the server healthy", Transform: transform.FromGo()},
+			{Name: "server_status", Type: proto.ColumnType_STRING, Description: "The status of the server, example 'leader', 'voter' or 'non-voter'"},
+			{Name: "node_status", Type: proto.ColumnType_STRING, Description: "The status of the node, example 'alive'"},
+			{Name: "last_contact", Type: proto.ColumnType_STRING, Description: "Time since the leader last contacted the server, example '2.51ms'"},
+			{Name: "last_term", Type: proto.ColumnType_INT, Description: "The last Raft term seen by the server"},
+			{Name: "last_index", Type: proto.ColumnType_INT, Description: "The last Raft index seen by the server"},
+			{Name: "stable_since", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the server last became healthy"},
+			{Name: "version", Type: proto.ColumnType_STRING, Description: "Hashicorp Vault Version of the server"},
+			{Name: "redundancy_zone", Type: proto.ColumnType_STRING, Description: "The redundancy zone of the server (Enterprise only)"},
+		},
+	}
+}
+
+func listRaftAutopilot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := conn.Sys().RaftAutopilotState()
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, nil
+	}
+
+	for _, server := range state.Servers {
+		s := &RaftAutopilotServer{
+			Healthy:          state.Healthy,
+			FailureTolerance: state.FailureTolerance,
+			Leader:           state.Leader,
+			ServerID:         server.ID,
+			ServerName:       server.Name,
+			ServerAddress:    server.Address,
+			ServerHealthy:    server.Healthy,
+			ServerStatus:     server.Status,
+			NodeStatus:       server.NodeStatus,
+			LastContact:      server.LastContact,
+			LastTerm:         server.LastTerm,
+			LastIndex:        server.LastIndex,
+			Version:          server.Version,
+			RedundancyZone:   server.RedundancyZone,
+		}
+
+		if stableSince, err := time.Parse(time.RFC3339Nano, server.StableSince); err == nil {
+			s.StableSince = &stableSince
+		}
+
+		d.StreamListItem(ctx, s)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type RaftPeer struct {
+	NodeID          string
+	Address         string
+	Leader          bool
+	Voter           bool
+	ProtocolVersion string
+}
+
+func tableRaftPeer() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_raft_peer",
+		Description: "Vault Raft (integrated storage) peers",
+		List: &plugin.ListConfig{
+			Hydrate: listRaftPeers,
+		},
+		Columns: []*plugin.Column{
+			{Name: "node_id", Type: proto.ColumnType_STRING, Description: "The identifier of the node", Transform: transform.FromField("NodeID")},
+			{Name: "address", Type: proto.ColumnType_STRING, Description: "The cluster address of the node"},
+			{Name: "leader", Type: proto.ColumnType_BOOL, Description: "Is the node the leader", Transform: transform.FromGo()},
+			{Name: "voter", Type: proto.ColumnType_BOOL, Description: "Is the node a voter in elections", Transform: transform.FromGo()},
+			{Name: "protocol_version", Type: proto.ColumnType_STRING, Description: "The Raft protocol version of the node"},
+		},
+	}
+}
+
+func listRaftPeers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().Read("sys/storage/raft/configuration")
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	config := getMap(data.Data, "config")
+	servers, _ := config["servers"].([]interface{})
+	for _, s := range servers {
+		server, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		d.StreamListItem(ctx, &RaftPeer{
+			NodeID:          getString(server, "node_id"),
+			Address:         getString(server, "address"),
+			Leader:          getBool(server, "leader"),
+			Voter:           getBool(server, "voter"),
+			ProtocolVersion: getString(server, "protocol_version"),
+		})
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,230 @@
+package vault
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// RaftSnapshotPrefix The number of keys and their size for a single top level prefix (e.g. logical/) of a Raft snapshot.
+// Index, Term and Size are taken from the snapshot metadata and are the same for every prefix.
+type RaftSnapshotPrefix struct {
+	SnapshotPath       string
+	SnapshotID         string
+	Index              uint64
+	Term               uint64
+	ConfigurationIndex uint64
+	Size               int64
+	Prefix             string
+	KeyCount           int64
+	ValueBytes         int64
+}
+
+// The metadata of a snapshot as written to meta.json
+type raftSnapshotMeta struct {
+	ID                 string `json:"ID"`
+	Index              uint64 `json:"Index"`
+	Term               uint64 `json:"Term"`
+	ConfigurationIndex uint64 `json:"ConfigurationIndex"`
+	Size               int64  `json:"Size"`
+}
+
+func tableRaftSnapshot() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_raft_snapshot",
+		Description: "Contents of a local Vault Raft (integrated storage) snapshot file",
+		List: &plugin.ListConfig{
+			Hydrate:    listRaftSnapshot,
+			KeyColumns: plugin.OptionalColumns([]string{"snapshot_path"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "snapshot_path", Type: proto.ColumnType_STRING, Description: "The path of the snapshot file"},
+			{Name: "snapshot_id", Type: proto.ColumnType_STRING, Description: "The identifier of the snapshot", Transform: transform.FromField("SnapshotID")},
+			{Name: "index", Type: proto.ColumnType_INT, Description: "The Raft index the snapshot was taken at"},
+			{Name: "term", Type: proto.ColumnType_INT, Description: "The Raft term the snapshot was taken in"},
+			{Name: "configuration_index", Type: proto.ColumnType_INT, Description: "The Raft index of the cluster configuration contained in the snapshot"},
+			{Name: "size", Type: proto.ColumnType_INT, Description: "The size of the snapshot data in bytes"},
+			{Name: "prefix", Type: proto.ColumnType_STRING, Description: "The top level storage prefix, example 'logical/', 'sys/' or 'core/'"},
+			{Name: "key_count", Type: proto.ColumnType_INT, Description: "The number of keys stored under the prefix", Transform: transform.FromGo()},
+			{Name: "value_bytes", Type: proto.ColumnType_INT, Description: "The total size of the (encrypted) values stored under the prefix in bytes", Transform: transform.FromGo()},
+		},
+	}
+}
+
+// The function called by steampipe to populate the table. Reads the snapshot given by the snapshot_path qual,
+// falling back to the configured raft_snapshot_path
+func listRaftSnapshot(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	path := d.EqualsQuals["snapshot_path"].GetStringValue()
+	if path == "" {
+		config := GetConfig(d.Connection)
+		if config.RaftSnapshotPath != nil {
+			path = *config.RaftSnapshotPath
+		}
+	}
+	if path == "" {
+		return nil, errors.New("snapshot_path must be supplied as a qual or raft_snapshot_path set in the connection configuration file to query vault_raft_snapshot")
+	}
+
+	meta, prefixes, err := readRaftSnapshot(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range prefixes {
+		p.SnapshotPath = path
+		p.SnapshotID = meta.ID
+		p.Index = meta.Index
+		p.Term = meta.Term
+		p.ConfigurationIndex = meta.ConfigurationIndex
+		p.Size = meta.Size
+		d.StreamListItem(ctx, p)
+	}
+
+	return nil, nil
+}
+
+// Reads a snapshot as saved by `vault operator raft snapshot save`, a gzipped tar archive containing
+// meta.json (the Raft snapshot metadata) and state.bin (the storage entries)
+func readRaftSnapshot(path string) (*raftSnapshotMeta, map[string]*RaftSnapshotPrefix, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s is not a Vault Raft snapshot: %w", path, err)
+	}
+	defer gz.Close()
+
+	var meta *raftSnapshotMeta
+	var prefixes map[string]*RaftSnapshotPrefix
+
+	archive := tar.NewReader(gz)
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch header.Name {
+		case "meta.json":
+			meta = &raftSnapshotMeta{}
+			if err := json.NewDecoder(archive).Decode(meta); err != nil {
+				return nil, nil, err
+			}
+		case "state.bin":
+			prefixes, err = countRaftSnapshotEntries(archive, header.Size)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if meta == nil || prefixes == nil {
+		return nil, nil, fmt.Errorf("%s is not a Vault Raft snapshot, meta.json or state.bin missing", path)
+	}
+
+	return meta, prefixes, nil
+}
+
+// Counts the storage entries of state.bin per top level prefix. Entries are written as length delimited
+// (uvarint) protobuf messages, with the key as field 1 and the value as field 2. size is the size of state.bin, used to
+// reject lengths of corrupt or truncated snapshots before allocating them
+func countRaftSnapshotEntries(r io.Reader, size int64) (map[string]*RaftSnapshotPrefix, error) {
+	prefixes := map[string]*RaftSnapshotPrefix{}
+	reader := bufio.NewReader(r)
+	remaining := uint64(size)
+
+	for {
+		length, err := binary.ReadUvarint(reader)
+		if err == io.EOF {
+			return prefixes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entrySize := uint64(protowire.SizeVarint(length)) + length
+		if length > remaining || entrySize > remaining {
+			return nil, fmt.Errorf("corrupt Raft snapshot, entry of %d bytes exceeds the %d bytes remaining in state.bin", length, remaining)
+		}
+		remaining -= entrySize
+
+		msg := make([]byte, length)
+		if _, err := io.ReadFull(reader, msg); err != nil {
+			return nil, err
+		}
+
+		key, value, err := parseRaftStorageEntry(msg)
+		if err != nil {
+			return nil, err
+		}
+
+		prefix := key
+		if i := strings.Index(key, "/"); i >= 0 {
+			prefix = key[:i+1]
+		}
+
+		if prefixes[prefix] == nil {
+			prefixes[prefix] = &RaftSnapshotPrefix{Prefix: prefix}
+		}
+		prefixes[prefix].KeyCount++
+		prefixes[prefix].ValueBytes += int64(len(value))
+	}
+}
+
+// Obtains the key and value of a StorageEntry protobuf message
+func parseRaftStorageEntry(msg []byte) (string, []byte, error) {
+	var key string
+	var value []byte
+
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		if n < 0 {
+			return "", nil, protowire.ParseError(n)
+		}
+		msg = msg[n:]
+
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, msg)
+			if n < 0 {
+				return "", nil, protowire.ParseError(n)
+			}
+			msg = msg[n:]
+			continue
+		}
+
+		b, n := protowire.ConsumeBytes(msg)
+		if n < 0 {
+			return "", nil, protowire.ParseError(n)
+		}
+		msg = msg[n:]
+
+		switch num {
+		case 1:
+			key = string(b)
+		case 2:
+			value = b
+		}
+	}
+
+	return key, value, nil
+}
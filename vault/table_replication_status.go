@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// ReplicationStatus The status of a single type of replication, dr or performance.
+type ReplicationStatus struct {
+	ReplicationType    string
+	Mode               string
+	State              string
+	ConnectionState    string
+	ClusterID          string
+	PrimaryClusterAddr string
+	KnownSecondaries   []string
+	Primaries          []interface{}
+	Secondaries        []interface{}
+	LastWal            int64
+	LastRemoteWal      int64
+	LastReindexEpoch   string
+	MerkleRoot         string
+}
+
+func tableReplicationStatus() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_replication_status",
+		Description: "Status of Vault Performance and Disaster Recovery replication",
+		List: &plugin.ListConfig{
+			Hydrate: listReplicationStatus,
+		},
+		Columns: []*plugin.Column{
+			{Name: "replication_type", Type: proto.ColumnType_STRING, Description: "The type of replication, dr or performance"},
+			{Name: "mode", Type: proto.ColumnType_STRING, Description: "The replication mode of the cluster, primary, secondary or disabled"},
+			{Name: "state", Type: proto.ColumnType_STRING, Description: "The replication state of the cluster, example 'running' or 'stream-wals'"},
+			{Name: "connection_state", Type: proto.ColumnType_STRING, Description: "The state of the connection to the primary (secondaries only)"},
+			{Name: "cluster_id", Type: proto.ColumnType_STRING, Description: "The identifier of the replication set", Transform: transform.FromField("ClusterID")},
+			{Name: "primary_cluster_addr", Type: proto.ColumnType_STRING, Description: "The cluster address of the primary"},
+			{Name: "known_secondaries", Type: proto.ColumnType_JSON, Description: "Array of identifiers of secondaries known to the primary (primaries only)"},
+			{Name: "primaries", Type: proto.ColumnType_JSON, Description: "Array of primaries and their connection state (secondaries only)"},
+			{Name: "secondaries", Type: proto.ColumnType_JSON, Description: "Array of secondaries and their connection state, including last heartbeat (primaries only)"},
+			{Name: "last_wal", Type: proto.ColumnType_INT, Description: "Value of last WAL written", Transform: transform.FromGo()},
+			{Name: "last_remote_wal", Type: proto.ColumnType_INT, Description: "Value of last WAL received from the primary (secondaries only)", Transform: transform.FromGo()},
+			{Name: "last_reindex_epoch", Type: proto.ColumnType_STRING, Description: "The epoch of the last reindex"},
+			{Name: "merkle_root", Type: proto.ColumnType_STRING, Description: "The merkle root of the replicated data, equal on primary and secondary when in sync"},
+		},
+	}
+}
+
+func listReplicationStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().Read("sys/replication/status")
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	for _, replicationType := range []string{"dr", "performance"} {
+		status := getMap(data.Data, replicationType)
+		if status == nil {
+			continue
+		}
+
+		primaries, _ := status["primaries"].([]interface{})
+		secondaries, _ := status["secondaries"].([]interface{})
+
+		d.StreamListItem(ctx, &ReplicationStatus{
+			ReplicationType:    replicationType,
+			Mode:               getString(status, "mode"),
+			State:              getString(status, "state"),
+			ConnectionState:    getString(status, "connection_state"),
+			ClusterID:          getString(status, "cluster_id"),
+			PrimaryClusterAddr: getString(status, "primary_cluster_addr"),
+			KnownSecondaries:   getValues(status, "known_secondaries"),
+			Primaries:          primaries,
+			Secondaries:        secondaries,
+			LastWal:            getInt64(status, "last_wal"),
+			LastRemoteWal:      getInt64(status, "last_remote_wal"),
+			LastReindexEpoch:   getString(status, "last_reindex_epoch"),
+			MerkleRoot:         getString(status, "merkle_root"),
+		})
+	}
+
+	return nil, nil
+}
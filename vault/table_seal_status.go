@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type SysSealStatus struct {
+	Type         string
+	Initialized  bool
+	Sealed       bool
+	T            int
+	N            int
+	Progress     int
+	Nonce        string
+	Version      string
+	BuildDate    string
+	Migration    bool
+	RecoverySeal bool
+	StorageType  string
+	ClusterName  string
+	ClusterID    string
+}
+
+func tableSysSealStatus() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_sys_seal_status",
+		Description: "Seal status of Vault",
+		List: &plugin.ListConfig{
+			Hydrate: getSysSealStatus,
+		},
+		Columns: []*plugin.Column{
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of seal, example 'shamir' or 'awskms'"},
+			{Name: "initialized", Type: proto.ColumnType_BOOL, Description: "Is Initialized", Transform: transform.FromGo()},
+			{Name: "sealed", Type: proto.ColumnType_BOOL, Description: "Is sealed", Transform: transform.FromGo()},
+			{Name: "t", Type: proto.ColumnType_INT, Description: "Threshold of key shares required to unseal", Transform: transform.FromGo()},
+			{Name: "n", Type: proto.ColumnType_INT, Description: "Total number of key shares", Transform: transform.FromGo()},
+			{Name: "progress", Type: proto.ColumnType_INT, Description: "Number of key shares provided in the current unseal attempt", Transform: transform.FromGo()},
+			{Name: "nonce", Type: proto.ColumnType_STRING, Description: "Nonce of the current unseal attempt"},
+			{Name: "version", Type: proto.ColumnType_STRING, Description: "Hashicorp Vault Version"},
+			{Name: "build_date", Type: proto.ColumnType_STRING, Description: "Build date of the Hashicorp Vault Version"},
+			{Name: "migration", Type: proto.ColumnType_BOOL, Description: "Is a seal migration in progress", Transform: transform.FromGo()},
+			{Name: "recovery_seal", Type: proto.ColumnType_BOOL, Description: "Is the seal using recovery keys (auto unseal)", Transform: transform.FromGo()},
+			{Name: "storage_type", Type: proto.ColumnType_STRING, Description: "The type of storage backend, example 'raft'"},
+			{Name: "cluster_name", Type: proto.ColumnType_STRING, Description: "Name of Vault Cluster"},
+			{Name: "cluster_id", Type: proto.ColumnType_STRING, Description: "Identity of Vault Cluster", Transform: transform.FromField("ClusterID")},
+		},
+	}
+}
+
+func getSysSealStatus(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connectUnauthenticated(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Sys().SealStatus()
+	if err != nil {
+		return nil, err
+	}
+
+	d.StreamListItem(ctx, &SysSealStatus{
+		Type:         data.Type,
+		Initialized:  data.Initialized,
+		Sealed:       data.Sealed,
+		T:            data.T,
+		N:            data.N,
+		Progress:     data.Progress,
+		Nonce:        data.Nonce,
+		Version:      data.Version,
+		BuildDate:    data.BuildDate,
+		Migration:    data.Migration,
+		RecoverySeal: data.RecoverySeal,
+		StorageType:  data.StorageType,
+		ClusterName:  data.ClusterName,
+		ClusterID:    data.ClusterID,
+	})
+
+	return nil, nil
+}
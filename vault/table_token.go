@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// Number of parallel accessor lookups used when token_lookup_concurrency is not configured
+const defaultTokenLookupConcurrency = 10
+
+type Token struct {
+	Accessor     string
+	DisplayName  string
+	Path         string
+	Type         string
+	Policies     []string
+	EntityID     string
+	Ttl          int64
+	CreationTime int64
+	ExpireTime   *time.Time
+	Orphan       bool
+	Meta         map[string]interface{}
+}
+
+func tableToken() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_token",
+		Description: "Vault tokens, enumerated via their accessors",
+		List: &plugin.ListConfig{
+			Hydrate:    listTokens,
+			KeyColumns: plugin.OptionalColumns([]string{"accessor", "policy"}),
+		},
+		Columns: []*plugin.Column{
+			{Name: "accessor", Type: proto.ColumnType_STRING, Description: "The accessor of the token"},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the token"},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path the token was created on, example 'auth/token/create'"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of token, service or batch"},
+			{Name: "policies", Type: proto.ColumnType_JSON, Description: "Array of policies attached to the token"},
+			{Name: "policy", Type: proto.ColumnType_STRING, Description: "Filter to only return tokens with this policy attached", Transform: transform.FromQual("policy")},
+			{Name: "entity_id", Type: proto.ColumnType_STRING, Description: "The identifier of the identity entity the token belongs to", Transform: transform.FromField("EntityID")},
+			{Name: "ttl", Type: proto.ColumnType_INT, Description: "Remaining time to live of the token in seconds (0 if the token does not expire)", Transform: transform.FromGo()},
+			{Name: "creation_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the token was created", Transform: transform.FromField("CreationTime").Transform(convertTimestamp)},
+			{Name: "expire_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the token expires, empty if it does not expire"},
+			{Name: "orphan", Type: proto.ColumnType_BOOL, Description: "Indication if the token is an orphan (has no parent)", Transform: transform.FromGo()},
+			{Name: "meta", Type: proto.ColumnType_JSON, Description: "Metadata associated with the token"},
+		},
+	}
+}
+
+// Worker to look up the token details of the accessors received on accessorsChan.
+// Accessors which can no longer be looked up (e.g. revoked since listing) are skipped, any other error is sent on errsChan
+// after which the worker stops and cancels the remaining lookups
+func lookupTokens(ctx context.Context, cancel context.CancelFunc, client *api.Client, accessorsChan chan string, tokensChan chan *Token, errsChan chan error, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	for accessor := range accessorsChan {
+		data, err := client.Auth().Token().LookupAccessor(accessor)
+		if hasStatusCode(err, http.StatusBadRequest) {
+			continue
+		}
+		if err != nil {
+			errsChan <- err
+			cancel()
+			return
+		}
+		if data == nil {
+			continue
+		}
+
+		tokensChan <- &Token{
+			Accessor:     getString(data.Data, "accessor"),
+			DisplayName:  getString(data.Data, "display_name"),
+			Path:         getString(data.Data, "path"),
+			Type:         getString(data.Data, "type"),
+			Policies:     getValues(data.Data, "policies"),
+			EntityID:     getString(data.Data, "entity_id"),
+			Ttl:          getInt64(data.Data, "ttl"),
+			CreationTime: getInt64(data.Data, "creation_time"),
+			ExpireTime:   getTime(data.Data, "expire_time"),
+			Orphan:       getBool(data.Data, "orphan"),
+			Meta:         getMap(data.Data, "meta"),
+		}
+	}
+}
+
+// The function called by steampipe to populate the table. Lists all accessors and looks them up in parallel
+func listTokens(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	policy := quals["policy"].GetStringValue()
+
+	var accessors []string
+	if quals["accessor"] != nil {
+		accessors = []string{quals["accessor"].GetStringValue()}
+	} else {
+		data, err := conn.Logical().List("auth/token/accessors")
+		if err != nil {
+			return nil, err
+		}
+		accessors = getSecretAsStrings(data)
+	}
+
+	concurrency := defaultTokenLookupConcurrency
+	config := GetConfig(d.Connection)
+	if config.TokenLookupConcurrency != nil && *config.TokenLookupConcurrency > 0 {
+		concurrency = *config.TokenLookupConcurrency
+	}
+
+	var wg sync.WaitGroup
+	accessorsChan := make(chan string)
+	tokensChan := make(chan *Token)
+
+	// Every worker sends at most one error, so this never blocks
+	errsChan := make(chan error, concurrency)
+	lookupCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		defer close(accessorsChan)
+		for _, accessor := range accessors {
+			select {
+			case accessorsChan <- accessor:
+			case <-lookupCtx.Done():
+				return
+			}
+		}
+	}()
+
+	// Workers for parallel requests
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go lookupTokens(lookupCtx, cancel, conn, accessorsChan, tokensChan, errsChan, &wg)
+	}
+
+	go func() {
+		wg.Wait()
+		close(tokensChan)
+	}()
+
+	for t := range tokensChan {
+		if policy != "" && !containsString(t.Policies, policy) {
+			continue
+		}
+		d.StreamListItem(ctx, t)
+	}
+
+	select {
+	case err := <-errsChan:
+		return nil, err
+	default:
+	}
+
+	return nil, nil
+}
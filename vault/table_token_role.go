@@ -0,0 +1,122 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+type TokenRole struct {
+	Name                 string
+	AllowedPolicies      []string
+	DisallowedPolicies   []string
+	AllowedEntityAliases []string
+	Orphan               bool
+	Renewable            bool
+	TokenPeriod          int64
+	TokenExplicitMaxTtl  int64
+	TokenType            string
+	PathSuffix           string
+	TokenBoundCidrs      []string
+}
+
+func tableTokenRole() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_token_role",
+		Description: "Vault Token Auth Roles",
+		List: &plugin.ListConfig{
+			Hydrate: listTokenRoles,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns: plugin.SingleColumn("name"),
+			Hydrate:    getTokenRole,
+		},
+		Columns: []*plugin.Column{
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the token role"},
+			{Name: "allowed_policies", Type: proto.ColumnType_JSON, Description: "Array of policies tokens created against the role may have"},
+			{Name: "disallowed_policies", Type: proto.ColumnType_JSON, Description: "Array of policies tokens created against the role may not have"},
+			{Name: "allowed_entity_aliases", Type: proto.ColumnType_JSON, Description: "Array of entity aliases tokens created against the role may be assigned to"},
+			{Name: "orphan", Type: proto.ColumnType_BOOL, Description: "Indication if tokens created against the role are orphans (have no parent)", Transform: transform.FromGo()},
+			{Name: "renewable", Type: proto.ColumnType_BOOL, Description: "Indication if tokens created against the role are renewable", Transform: transform.FromGo()},
+			{Name: "token_period", Type: proto.ColumnType_INT, Description: "Period of periodic tokens created against the role in seconds (0 if not periodic)", Transform: transform.FromGo()},
+			{Name: "token_explicit_max_ttl", Type: proto.ColumnType_INT, Description: "Explicit Max TTL of tokens created against the role in seconds (0 if not set)", Transform: transform.FromGo()},
+			{Name: "token_type", Type: proto.ColumnType_STRING, Description: "The type of tokens created against the role, example 'default-service'"},
+			{Name: "path_suffix", Type: proto.ColumnType_STRING, Description: "Suffix appended to the path of tokens created against the role"},
+			{Name: "token_bound_cidrs", Type: proto.ColumnType_JSON, Description: "Array of CIDR blocks tokens created against the role may be used from", Transform: transform.FromField("TokenBoundCidrs")},
+		},
+	}
+}
+
+// Function called by Steampipe to populate the table.
+func listTokenRoles(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().List("auth/token/roles")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range getSecretAsStrings(data) {
+		role, err := getTokenRoleDetails(conn, name)
+		if err != nil {
+			return nil, err
+		}
+		if role != nil {
+			d.StreamListItem(ctx, role)
+		}
+	}
+
+	return nil, nil
+}
+
+// Fetches a single token role, essentially a check on if it exists
+func getTokenRole(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	quals := d.EqualsQuals
+	name := quals["name"].GetStringValue()
+
+	role, err := getTokenRoleDetails(conn, name)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, nil
+	}
+
+	return role, nil
+}
+
+func getTokenRoleDetails(client *api.Client, name string) (*TokenRole, error) {
+	data, err := client.Logical().Read(fmt.Sprintf("auth/token/roles/%s", name))
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	role := &TokenRole{Name: name}
+	role.AllowedPolicies = getValues(data.Data, "allowed_policies")
+	role.DisallowedPolicies = getValues(data.Data, "disallowed_policies")
+	role.AllowedEntityAliases = getValues(data.Data, "allowed_entity_aliases")
+	role.Orphan = getBool(data.Data, "orphan")
+	role.Renewable = getBool(data.Data, "renewable")
+	role.TokenPeriod = getInt64(data.Data, "token_period")
+	role.TokenExplicitMaxTtl = getInt64(data.Data, "token_explicit_max_ttl")
+	role.TokenType = getString(data.Data, "token_type")
+	role.PathSuffix = getString(data.Data, "path_suffix")
+	role.TokenBoundCidrs = getValues(data.Data, "token_bound_cidrs")
+
+	return role, nil
+}
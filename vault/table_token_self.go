@@ -0,0 +1,95 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+// TokenSelf The token the plugin is connecting with, as returned by auth/token/lookup-self.
+// The token itself (id) is deliberately not exposed.
+type TokenSelf struct {
+	Accessor         string
+	DisplayName      string
+	Path             string
+	Type             string
+	Policies         []string
+	IdentityPolicies []string
+	EntityID         string
+	Ttl              int64
+	CreationTime     int64
+	IssueTime        *time.Time
+	ExpireTime       *time.Time
+	Renewable        bool
+	Orphan           bool
+	NumUses          int64
+	Meta             map[string]interface{}
+	AuthType         string
+}
+
+func tableTokenSelf() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_token_self",
+		Description: "The Vault token used by the plugin",
+		List: &plugin.ListConfig{
+			Hydrate: getTokenSelf,
+		},
+		Columns: []*plugin.Column{
+			{Name: "accessor", Type: proto.ColumnType_STRING, Description: "The accessor of the token"},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the token"},
+			{Name: "path", Type: proto.ColumnType_STRING, Description: "The path the token was created on, example 'auth/token/create'"},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of token, service or batch"},
+			{Name: "policies", Type: proto.ColumnType_JSON, Description: "Array of policies attached to the token"},
+			{Name: "identity_policies", Type: proto.ColumnType_JSON, Description: "Array of policies inherited through the identity entity and its groups"},
+			{Name: "entity_id", Type: proto.ColumnType_STRING, Description: "The identifier of the identity entity the token belongs to", Transform: transform.FromField("EntityID")},
+			{Name: "ttl", Type: proto.ColumnType_INT, Description: "Remaining time to live of the token in seconds (0 if the token does not expire)", Transform: transform.FromGo()},
+			{Name: "creation_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the token was created", Transform: transform.FromField("CreationTime").Transform(convertTimestamp)},
+			{Name: "issue_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the token was issued"},
+			{Name: "expire_time", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the token expires, empty if it does not expire"},
+			{Name: "renewable", Type: proto.ColumnType_BOOL, Description: "Indication if the token is renewable", Transform: transform.FromGo()},
+			{Name: "orphan", Type: proto.ColumnType_BOOL, Description: "Indication if the token is an orphan (has no parent)", Transform: transform.FromGo()},
+			{Name: "num_uses", Type: proto.ColumnType_INT, Description: "Number of uses left for the token (0 is unlimited)", Transform: transform.FromGo()},
+			{Name: "meta", Type: proto.ColumnType_JSON, Description: "Metadata associated with the token"},
+			{Name: "auth_type", Type: proto.ColumnType_STRING, Description: "The auth type the plugin used to obtain the token, token or aws"},
+		},
+	}
+}
+
+func getTokenSelf(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Auth().Token().LookupSelf()
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	d.StreamListItem(ctx, &TokenSelf{
+		Accessor:         getString(data.Data, "accessor"),
+		DisplayName:      getString(data.Data, "display_name"),
+		Path:             getString(data.Data, "path"),
+		Type:             getString(data.Data, "type"),
+		Policies:         getValues(data.Data, "policies"),
+		IdentityPolicies: getValues(data.Data, "identity_policies"),
+		EntityID:         getString(data.Data, "entity_id"),
+		Ttl:              getInt64(data.Data, "ttl"),
+		CreationTime:     getInt64(data.Data, "creation_time"),
+		IssueTime:        getTime(data.Data, "issue_time"),
+		ExpireTime:       getTime(data.Data, "expire_time"),
+		Renewable:        getBool(data.Data, "renewable"),
+		Orphan:           getBool(data.Data, "orphan"),
+		NumUses:          getInt64(data.Data, "num_uses"),
+		Meta:             getMap(data.Data, "meta"),
+		AuthType:         getAuthType(resolveConfig(d)),
+	})
+
+	return nil, nil
+}
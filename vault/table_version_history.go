@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+)
+
+// VersionHistory A version of Vault that has been run on the cluster
+type VersionHistory struct {
+	Version            string
+	TimestampInstalled *time.Time
+	BuildDate          *time.Time
+	PreviousVersion    string
+}
+
+func tableVersionHistory() *plugin.Table {
+	return &plugin.Table{
+		Name:        "vault_version_history",
+		Description: "Vault Version History of the cluster",
+		List: &plugin.ListConfig{
+			Hydrate: listVersionHistory,
+		},
+		Columns: []*plugin.Column{
+			{Name: "version", Type: proto.ColumnType_STRING, Description: "The version of Vault"},
+			{Name: "timestamp_installed", Type: proto.ColumnType_TIMESTAMP, Description: "The date and time the version was first run on the cluster"},
+			{Name: "build_date", Type: proto.ColumnType_TIMESTAMP, Description: "The build date of the version"},
+			{Name: "previous_version", Type: proto.ColumnType_STRING, Description: "The version run on the cluster before this version"},
+		},
+	}
+}
+
+func listVersionHistory(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	conn, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := conn.Logical().List("sys/version-history")
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, nil
+	}
+
+	keyInfo := getMap(data.Data, "key_info")
+	for _, version := range getSecretAsStrings(data) {
+		info := getMap(keyInfo, version)
+		d.StreamListItem(ctx, &VersionHistory{
+			Version:            version,
+			TimestampInstalled: getTime(info, "timestamp_installed"),
+			BuildDate:          getTime(info, "build_date"),
+			PreviousVersion:    getString(info, "previous_version"),
+		})
+	}
+
+	return nil, nil
+}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -15,25 +16,49 @@ import (
 )
 
 func connect(ctx context.Context, d *plugin.QueryData) (*api.Client, error) {
-	addr := os.Getenv("VAULT_ADDR")
-	tkn := os.Getenv("VAULT_TOKEN")
-	defaultAuthType := "token"
+	vaultConfig := resolveConfig(d)
 
-	// In line with the vault CLI, these values can be set through environment variables.
-	vaultConfig := GetConfig(d.Connection)
+	client, err := newClient(vaultConfig)
+	if err != nil {
+		return nil, err
+	}
 
-	if vaultConfig.Address == nil {
-		vaultConfig.Address = &addr
+	if *vaultConfig.AuthType == "token" && *vaultConfig.Token == "" {
+		return nil, errors.New("Token must be set via environment or config file when using token auth_type")
 	}
 
-	if vaultConfig.Token == nil {
-		vaultConfig.Token = &tkn
+	if *vaultConfig.Token != "" {
+		client.SetToken(*vaultConfig.Token)
+		return client, nil
 	}
 
-	if vaultConfig.AuthType == nil {
-		vaultConfig.AuthType = &defaultAuthType
+	switch *vaultConfig.AuthType {
+	case "aws":
+		return AwsClient(&vaultConfig, client)
+	default:
+		return nil, errors.New(fmt.Sprintf("Unknown AuthType %s", *vaultConfig.AuthType))
+	}
+}
+
+// Connects to Vault without requiring authentication, used for endpoints which are available without a token (e.g. sys/seal-status).
+// A configured token is still used when present.
+func connectUnauthenticated(ctx context.Context, d *plugin.QueryData) (*api.Client, error) {
+	vaultConfig := resolveConfig(d)
+
+	client, err := newClient(vaultConfig)
+	if err != nil {
+		return nil, err
 	}
 
+	if *vaultConfig.Token != "" {
+		client.SetToken(*vaultConfig.Token)
+	}
+
+	return client, nil
+}
+
+// Creates a Vault client for the configured address, without any authentication
+func newClient(vaultConfig vaultConfig) (*api.Client, error) {
 	if *vaultConfig.Address == "" {
 		return nil, errors.New("Vault Address must be set either in VAULT_ADDR environment variable or in connection configuration file.")
 	}
@@ -41,7 +66,6 @@ func connect(ctx context.Context, d *plugin.QueryData) (*api.Client, error) {
 	var httpClient = &http.Client{
 		Timeout: 10 * time.Second,
 	}
-	var err error
 
 	apiConfig := &api.Config{Address: *vaultConfig.Address, HttpClient: httpClient}
 	client, err := api.NewClient(apiConfig)
@@ -50,21 +74,40 @@ func connect(ctx context.Context, d *plugin.QueryData) (*api.Client, error) {
 		return nil, errors.New(err.Error())
 	}
 
-	if *vaultConfig.AuthType == "token" && *vaultConfig.Token == "" {
-		return nil, errors.New("Token must be set via environment or config file when using token auth_type")
+	return client, nil
+}
+
+// Obtains the connection configuration with any unset values taken from the environment or their defaults
+func resolveConfig(d *plugin.QueryData) vaultConfig {
+	addr := os.Getenv("VAULT_ADDR")
+	tkn := os.Getenv("VAULT_TOKEN")
+	defaultAuthType := "token"
+
+	// In line with the vault CLI, these values can be set through environment variables.
+	vaultConfig := GetConfig(d.Connection)
+
+	if vaultConfig.Address == nil {
+		vaultConfig.Address = &addr
 	}
 
-	if *vaultConfig.Token != "" {
-		client.SetToken(*vaultConfig.Token)
-		return client, nil
+	if vaultConfig.Token == nil {
+		vaultConfig.Token = &tkn
 	}
 
-	switch *vaultConfig.AuthType {
-	case "aws":
-		return AwsClient(&vaultConfig, client)
-	default:
-		return nil, errors.New(fmt.Sprintf("Unknown AuthType %s", *vaultConfig.AuthType))
+	if vaultConfig.AuthType == nil {
+		vaultConfig.AuthType = &defaultAuthType
 	}
+
+	return vaultConfig
+}
+
+// Util func to obtain the auth type connect() actually uses, a supplied token always takes precedence
+func getAuthType(config vaultConfig) string {
+	if *config.Token != "" {
+		return "token"
+	}
+
+	return *config.AuthType
 }
 
 // Util func to replace any double / with single ones, used to make concatenating paths easier
@@ -72,6 +115,123 @@ func replaceDoubleSlash(url string) string {
 	return strings.ReplaceAll(url, "//", "/")
 }
 
+// Cache keys and default ttl (in seconds) of the mount inventory, the connection cache already scopes the keys per connection.
+// A cached inventory is only reloaded on a lookup miss once it is older than mountCacheMinAge
+const (
+	mountCacheKey        = "vault_mounts"
+	authMountCacheKey    = "vault_auth_mounts"
+	defaultMountCacheTtl = 60
+	mountCacheMinAge     = 5 * time.Second
+)
+
+// A cached mount inventory and when it was listed
+type mountCacheEntry struct {
+	Mounts map[string]*api.MountOutput
+	Loaded time.Time
+}
+
+// Util func to obtain all secrets engines, cached per connection for mount_cache_ttl seconds.
+// Tokens without read access to sys/mounts fall back to the mounts visible to the token
+func getMounts(ctx context.Context, d *plugin.QueryData, client *api.Client) (map[string]*api.MountOutput, error) {
+	return getCachedMounts(ctx, d, mountCacheKey, func() (map[string]*api.MountOutput, error) {
+		mounts, err := client.Sys().ListMounts()
+		if isPermissionDenied(err) {
+			mounts, err = getUIMounts(client, "secret", err)
+		}
+		return mounts, err
+	})
+}
+
+// Util func to obtain all auth methods, cached per connection for mount_cache_ttl seconds.
+// Tokens without read access to sys/auth fall back to the auth methods visible to the token
+func getAuthMounts(ctx context.Context, d *plugin.QueryData, client *api.Client) (map[string]*api.AuthMount, error) {
+	return getCachedMounts(ctx, d, authMountCacheKey, func() (map[string]*api.MountOutput, error) {
+		auths, err := client.Sys().ListAuth()
+		if isPermissionDenied(err) {
+			auths, err = getUIMounts(client, "auth", err)
+		}
+		return auths, err
+	})
+}
+
+// Util func to obtain a mount inventory from the connection cache, listing it with list when it isn't cached
+func getCachedMounts(ctx context.Context, d *plugin.QueryData, key string, list func() (map[string]*api.MountOutput, error)) (map[string]*api.MountOutput, error) {
+	ttl := getMountCacheTtl(d)
+	if ttl > 0 {
+		if cached, ok := d.ConnectionCache.Get(ctx, key); ok {
+			return cached.(*mountCacheEntry).Mounts, nil
+		}
+	}
+
+	mounts, err := list()
+	if err != nil {
+		return nil, err
+	}
+
+	if ttl > 0 {
+		_ = d.ConnectionCache.SetWithTTL(ctx, key, &mountCacheEntry{Mounts: mounts, Loaded: time.Now()}, ttl)
+	}
+
+	return mounts, nil
+}
+
+// Util func to drop a cached mount inventory after a lookup missed, as the mount may have been created since it was listed.
+// Returns whether it was dropped, inventories younger than mountCacheMinAge are kept so a query missing on every row
+// (e.g. a join on accessor) doesn't relist the mounts for every row
+func invalidateMountCache(ctx context.Context, d *plugin.QueryData, key string) bool {
+	cached, ok := d.ConnectionCache.Get(ctx, key)
+	if !ok || time.Since(cached.(*mountCacheEntry).Loaded) < mountCacheMinAge {
+		return false
+	}
+
+	d.ConnectionCache.Delete(ctx, key)
+	return true
+}
+
+// Util func to obtain how long the mount inventory is cached, a ttl of 0 disables the cache
+func getMountCacheTtl(d *plugin.QueryData) time.Duration {
+	ttl := defaultMountCacheTtl
+	config := GetConfig(d.Connection)
+	if config.MountCacheTtl != nil {
+		ttl = *config.MountCacheTtl
+	}
+
+	return time.Duration(ttl) * time.Second
+}
+
+// Util func to obtain the mounts of a class (secret or auth) from sys/internal/ui/mounts, which is available to any token and
+// only returns the mounts the token has access to. The original error is returned if the fallback isn't available either
+func getUIMounts(client *api.Client, mountClass string, originalErr error) (map[string]*api.MountOutput, error) {
+	data, err := client.Logical().Read("sys/internal/ui/mounts")
+	if err != nil || data == nil {
+		return nil, originalErr
+	}
+
+	// The mounts are returned in the same format as sys/mounts, so they can be decoded into the same structure
+	raw, err := json.Marshal(data.Data[mountClass])
+	if err != nil {
+		return nil, err
+	}
+
+	mounts := map[string]*api.MountOutput{}
+	if err := json.Unmarshal(raw, &mounts); err != nil {
+		return nil, err
+	}
+
+	return mounts, nil
+}
+
+// Util func to check whether an error is a permission denied response from Vault
+func isPermissionDenied(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// Util func to check whether an error is a response from Vault with the given HTTP status code
+func hasStatusCode(err error, statusCode int) bool {
+	var respErr *api.ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == statusCode
+}
+
 // Util func to obtain filtered mounts from all mounts
 func filterMounts(in map[string]*api.MountOutput, mountType string) map[string]*api.MountOutput {
 	filtered := map[string]*api.MountOutput{}
@@ -85,6 +245,51 @@ func filterMounts(in map[string]*api.MountOutput, mountType string) map[string]*
 	return filtered
 }
 
+// Util func to obtain the path of the secrets engine or auth method with the given path or, if no path is given, accessor.
+// Returns an empty string if there is no such mount
+func findMountPath(mounts map[string]*api.MountOutput, path string, accessor string) string {
+	if path != "" {
+		if mounts[path] == nil {
+			return ""
+		}
+		return path
+	}
+
+	path, _ = findMountByAccessor(mounts, accessor)
+	return path
+}
+
+// Util func to find the secrets engine or auth method (and its path) with the given accessor, returns nil if there is none
+func findMountByAccessor(mounts map[string]*api.MountOutput, accessor string) (string, *api.MountOutput) {
+	for path, mount := range mounts {
+		if mount.Accessor == accessor {
+			return path, mount
+		}
+	}
+
+	return "", nil
+}
+
+// Util func to build the key identifying a plugin catalog entry, used to join mounts to the plugin catalog
+func getPluginCatalogKey(pluginType string, name string, version string) string {
+	if version == "" {
+		return fmt.Sprintf("%s/%s", pluginType, name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", pluginType, name, version)
+}
+
+// Util func to build the plugin catalog key of the plugin a mount is running. The running version is used when
+// available as builtin plugins don't have a plugin_version set
+func getMountPluginCatalogKey(pluginType string, mount *api.MountOutput) string {
+	version := mount.RunningVersion
+	if version == "" {
+		version = mount.PluginVersion
+	}
+
+	return getPluginCatalogKey(pluginType, mount.Type, version)
+}
+
 // Util func to obtain []string by key from map[string]interface
 func getValues(in map[string]interface{}, key string) []string {
 	if in[key] == nil {
@@ -99,6 +304,97 @@ func getValues(in map[string]interface{}, key string) []string {
 	return out
 }
 
+// Util func to obtain a string by key from map[string]interface
+func getString(in map[string]interface{}, key string) string {
+	s, _ := in[key].(string)
+	return s
+}
+
+// Util func to obtain a bool by key from map[string]interface
+func getBool(in map[string]interface{}, key string) bool {
+	b, _ := in[key].(bool)
+	return b
+}
+
+// Util func to obtain an int64 by key from map[string]interface, Vault returns numbers as json.Number
+func getInt64(in map[string]interface{}, key string) int64 {
+	switch v := in[key].(type) {
+	case json.Number:
+		i, err := v.Int64()
+		if err != nil {
+			f, _ := v.Float64()
+			return int64(f)
+		}
+		return i
+	case float64:
+		return int64(v)
+	case int:
+		return int64(v)
+	case int64:
+		return v
+	}
+
+	return 0
+}
+
+// Util func to obtain a float64 by key from map[string]interface, Vault returns numbers as json.Number
+func getFloat64(in map[string]interface{}, key string) float64 {
+	switch v := in[key].(type) {
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	case float64:
+		return v
+	}
+
+	return 0
+}
+
+// Util func to obtain a time by key from map[string]interface, returns nil if not set or not an RFC3339 timestamp
+func getTime(in map[string]interface{}, key string) *time.Time {
+	s, ok := in[key].(string)
+	if !ok || s == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return nil
+	}
+
+	return &t
+}
+
+// Util func to obtain a nested map by key from map[string]interface
+func getMap(in map[string]interface{}, key string) map[string]interface{} {
+	m, _ := in[key].(map[string]interface{})
+	return m
+}
+
+// Util func to obtain a slice of nested maps by key from map[string]interface, entries which aren't maps are skipped
+func getMaps(in map[string]interface{}, key string) []map[string]interface{} {
+	var out []map[string]interface{}
+	list, _ := in[key].([]interface{})
+	for _, l := range list {
+		if m, ok := l.(map[string]interface{}); ok {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+// Util func to check whether a slice of strings contains a value
+func containsString(in []string, value string) bool {
+	for _, s := range in {
+		if s == value {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Converts and api.Secret object into a slice of strings containing all secret paths
 func getSecretAsStrings(s *api.Secret) []string {
 	if s == nil || s.Data["keys"] == nil || len(s.Data["keys"].([]interface{})) == 0 {